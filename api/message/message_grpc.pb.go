@@ -19,24 +19,53 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Message_ListSession_FullMethodName       = "/message.Message/ListSession"
-	Message_SendMessage_FullMethodName       = "/message.Message/SendMessage"
-	Message_AckMessage_FullMethodName        = "/message.Message/AckMessage"
-	Message_DeleteUserSession_FullMethodName = "/message.Message/DeleteUserSession"
-	Message_ListUnReadMessage_FullMethodName = "/message.Message/ListUnReadMessage"
-	Message_CreateGroup_FullMethodName       = "/message.Message/CreateGroup"
-	Message_ListGroup_FullMethodName         = "/message.Message/ListGroup"
-	Message_DismissGroup_FullMethodName      = "/message.Message/DismissGroup"
-	Message_InviteMember_FullMethodName      = "/message.Message/InviteMember"
-	Message_MoveOutMember_FullMethodName     = "/message.Message/MoveOutMember"
-	Message_ApplyInGroup_FullMethodName      = "/message.Message/ApplyInGroup"
-	Message_HandleGroupApply_FullMethodName  = "/message.Message/HandleGroupApply"
-	Message_ExitGroup_FullMethodName         = "/message.Message/ExitGroup"
-	Message_UpdateGroupInfo_FullMethodName   = "/message.Message/UpdateGroupInfo"
-	Message_ListGroupMember_FullMethodName   = "/message.Message/ListGroupMember"
-	Message_SearchGroup_FullMethodName       = "/message.Message/SearchGroup"
-	Message_ListGroupApply_FullMethodName    = "/message.Message/ListGroupApply"
-	Message_CreateSession_FullMethodName     = "/message.Message/CreateSession"
+	Message_ListSession_FullMethodName            = "/message.Message/ListSession"
+	Message_SendMessage_FullMethodName            = "/message.Message/SendMessage"
+	Message_AckMessage_FullMethodName             = "/message.Message/AckMessage"
+	Message_DeleteUserSession_FullMethodName      = "/message.Message/DeleteUserSession"
+	Message_ListUnReadMessage_FullMethodName      = "/message.Message/ListUnReadMessage"
+	Message_CreateGroup_FullMethodName            = "/message.Message/CreateGroup"
+	Message_ListGroup_FullMethodName              = "/message.Message/ListGroup"
+	Message_DismissGroup_FullMethodName           = "/message.Message/DismissGroup"
+	Message_InviteMember_FullMethodName           = "/message.Message/InviteMember"
+	Message_MoveOutMember_FullMethodName          = "/message.Message/MoveOutMember"
+	Message_ApplyInGroup_FullMethodName           = "/message.Message/ApplyInGroup"
+	Message_HandleGroupApply_FullMethodName       = "/message.Message/HandleGroupApply"
+	Message_ExitGroup_FullMethodName              = "/message.Message/ExitGroup"
+	Message_UpdateGroupInfo_FullMethodName        = "/message.Message/UpdateGroupInfo"
+	Message_ListGroupMember_FullMethodName        = "/message.Message/ListGroupMember"
+	Message_SearchGroup_FullMethodName            = "/message.Message/SearchGroup"
+	Message_ListGroupApply_FullMethodName         = "/message.Message/ListGroupApply"
+	Message_CreateSession_FullMethodName          = "/message.Message/CreateSession"
+	Message_ListMessageReader_FullMethodName      = "/message.Message/ListMessageReader"
+	Message_ListHistory_FullMethodName            = "/message.Message/ListHistory"
+	Message_SearchMessage_FullMethodName          = "/message.Message/SearchMessage"
+	Message_PinSession_FullMethodName             = "/message.Message/PinSession"
+	Message_MuteSession_FullMethodName            = "/message.Message/MuteSession"
+	Message_ArchiveSession_FullMethodName         = "/message.Message/ArchiveSession"
+	Message_DeleteMessage_FullMethodName          = "/message.Message/DeleteMessage"
+	Message_ScheduleMessage_FullMethodName        = "/message.Message/ScheduleMessage"
+	Message_ListScheduledMessage_FullMethodName   = "/message.Message/ListScheduledMessage"
+	Message_CancelScheduledMessage_FullMethodName = "/message.Message/CancelScheduledMessage"
+	Message_RescheduleMessage_FullMethodName      = "/message.Message/RescheduleMessage"
+	Message_SetSessionTtl_FullMethodName          = "/message.Message/SetSessionTtl"
+	Message_SetSessionEncryption_FullMethodName   = "/message.Message/SetSessionEncryption"
+	Message_ForwardMessage_FullMethodName         = "/message.Message/ForwardMessage"
+	Message_GetMergedForward_FullMethodName       = "/message.Message/GetMergedForward"
+	Message_CreateBroadcast_FullMethodName        = "/message.Message/CreateBroadcast"
+	Message_GetBroadcastStats_FullMethodName      = "/message.Message/GetBroadcastStats"
+	Message_ListBroadcast_FullMethodName          = "/message.Message/ListBroadcast"
+	Message_ReadBroadcast_FullMethodName          = "/message.Message/ReadBroadcast"
+	Message_CreateWebhook_FullMethodName          = "/message.Message/CreateWebhook"
+	Message_ListWebhook_FullMethodName            = "/message.Message/ListWebhook"
+	Message_DeleteWebhook_FullMethodName          = "/message.Message/DeleteWebhook"
+	Message_ListWebhookDelivery_FullMethodName    = "/message.Message/ListWebhookDelivery"
+	Message_CardCallback_FullMethodName           = "/message.Message/CardCallback"
+	Message_UpdateCard_FullMethodName             = "/message.Message/UpdateCard"
+	Message_CreateExport_FullMethodName           = "/message.Message/CreateExport"
+	Message_GetExport_FullMethodName              = "/message.Message/GetExport"
+	Message_DownloadExport_FullMethodName         = "/message.Message/DownloadExport"
+	Message_GetUnreadSummary_FullMethodName       = "/message.Message/GetUnreadSummary"
 )
 
 // MessageClient is the client API for Message service.
@@ -61,6 +90,35 @@ type MessageClient interface {
 	SearchGroup(ctx context.Context, in *SearchGroupReq, opts ...grpc.CallOption) (*SearchGroupResp, error)
 	ListGroupApply(ctx context.Context, in *ListGroupApplyReq, opts ...grpc.CallOption) (*ListGroupApplyResp, error)
 	CreateSession(ctx context.Context, in *CreateSessionReq, opts ...grpc.CallOption) (*CreateSessionResp, error)
+	ListMessageReader(ctx context.Context, in *ListMessageReaderReq, opts ...grpc.CallOption) (*ListMessageReaderResp, error)
+	ListHistory(ctx context.Context, in *ListHistoryReq, opts ...grpc.CallOption) (*ListHistoryResp, error)
+	SearchMessage(ctx context.Context, in *SearchMessageReq, opts ...grpc.CallOption) (*SearchMessageResp, error)
+	PinSession(ctx context.Context, in *PinSessionReq, opts ...grpc.CallOption) (*PinSessionResp, error)
+	MuteSession(ctx context.Context, in *MuteSessionReq, opts ...grpc.CallOption) (*MuteSessionResp, error)
+	ArchiveSession(ctx context.Context, in *ArchiveSessionReq, opts ...grpc.CallOption) (*ArchiveSessionResp, error)
+	DeleteMessage(ctx context.Context, in *DeleteMessageReq, opts ...grpc.CallOption) (*DeleteMessageResp, error)
+	ScheduleMessage(ctx context.Context, in *ScheduleMessageReq, opts ...grpc.CallOption) (*ScheduleMessageResp, error)
+	ListScheduledMessage(ctx context.Context, in *ListScheduledMessageReq, opts ...grpc.CallOption) (*ListScheduledMessageResp, error)
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageReq, opts ...grpc.CallOption) (*CancelScheduledMessageResp, error)
+	RescheduleMessage(ctx context.Context, in *RescheduleMessageReq, opts ...grpc.CallOption) (*RescheduleMessageResp, error)
+	SetSessionTtl(ctx context.Context, in *SetSessionTtlReq, opts ...grpc.CallOption) (*SetSessionTtlResp, error)
+	SetSessionEncryption(ctx context.Context, in *SetSessionEncryptionReq, opts ...grpc.CallOption) (*SetSessionEncryptionResp, error)
+	ForwardMessage(ctx context.Context, in *ForwardMessageReq, opts ...grpc.CallOption) (*ForwardMessageResp, error)
+	GetMergedForward(ctx context.Context, in *GetMergedForwardReq, opts ...grpc.CallOption) (*GetMergedForwardResp, error)
+	CreateBroadcast(ctx context.Context, in *CreateBroadcastReq, opts ...grpc.CallOption) (*CreateBroadcastResp, error)
+	GetBroadcastStats(ctx context.Context, in *GetBroadcastStatsReq, opts ...grpc.CallOption) (*GetBroadcastStatsResp, error)
+	ListBroadcast(ctx context.Context, in *ListBroadcastReq, opts ...grpc.CallOption) (*ListBroadcastResp, error)
+	ReadBroadcast(ctx context.Context, in *ReadBroadcastReq, opts ...grpc.CallOption) (*ReadBroadcastResp, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateWebhookResp, error)
+	ListWebhook(ctx context.Context, in *ListWebhookReq, opts ...grpc.CallOption) (*ListWebhookResp, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*DeleteWebhookResp, error)
+	ListWebhookDelivery(ctx context.Context, in *ListWebhookDeliveryReq, opts ...grpc.CallOption) (*ListWebhookDeliveryResp, error)
+	CardCallback(ctx context.Context, in *CardCallbackReq, opts ...grpc.CallOption) (*CardCallbackResp, error)
+	UpdateCard(ctx context.Context, in *UpdateCardReq, opts ...grpc.CallOption) (*UpdateCardResp, error)
+	CreateExport(ctx context.Context, in *CreateExportReq, opts ...grpc.CallOption) (*CreateExportResp, error)
+	GetExport(ctx context.Context, in *GetExportReq, opts ...grpc.CallOption) (*GetExportResp, error)
+	DownloadExport(ctx context.Context, in *DownloadExportReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryReq, opts ...grpc.CallOption) (*GetUnreadSummaryResp, error)
 }
 
 type messageClient struct {
@@ -251,6 +309,305 @@ func (c *messageClient) CreateSession(ctx context.Context, in *CreateSessionReq,
 	return out, nil
 }
 
+func (c *messageClient) ListMessageReader(ctx context.Context, in *ListMessageReaderReq, opts ...grpc.CallOption) (*ListMessageReaderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessageReaderResp)
+	err := c.cc.Invoke(ctx, Message_ListMessageReader_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListHistory(ctx context.Context, in *ListHistoryReq, opts ...grpc.CallOption) (*ListHistoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHistoryResp)
+	err := c.cc.Invoke(ctx, Message_ListHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) SearchMessage(ctx context.Context, in *SearchMessageReq, opts ...grpc.CallOption) (*SearchMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessageResp)
+	err := c.cc.Invoke(ctx, Message_SearchMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) PinSession(ctx context.Context, in *PinSessionReq, opts ...grpc.CallOption) (*PinSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinSessionResp)
+	err := c.cc.Invoke(ctx, Message_PinSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) MuteSession(ctx context.Context, in *MuteSessionReq, opts ...grpc.CallOption) (*MuteSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteSessionResp)
+	err := c.cc.Invoke(ctx, Message_MuteSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ArchiveSession(ctx context.Context, in *ArchiveSessionReq, opts ...grpc.CallOption) (*ArchiveSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveSessionResp)
+	err := c.cc.Invoke(ctx, Message_ArchiveSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) DeleteMessage(ctx context.Context, in *DeleteMessageReq, opts ...grpc.CallOption) (*DeleteMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMessageResp)
+	err := c.cc.Invoke(ctx, Message_DeleteMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ScheduleMessage(ctx context.Context, in *ScheduleMessageReq, opts ...grpc.CallOption) (*ScheduleMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMessageResp)
+	err := c.cc.Invoke(ctx, Message_ScheduleMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListScheduledMessage(ctx context.Context, in *ListScheduledMessageReq, opts ...grpc.CallOption) (*ListScheduledMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledMessageResp)
+	err := c.cc.Invoke(ctx, Message_ListScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageReq, opts ...grpc.CallOption) (*CancelScheduledMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledMessageResp)
+	err := c.cc.Invoke(ctx, Message_CancelScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) RescheduleMessage(ctx context.Context, in *RescheduleMessageReq, opts ...grpc.CallOption) (*RescheduleMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleMessageResp)
+	err := c.cc.Invoke(ctx, Message_RescheduleMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) SetSessionTtl(ctx context.Context, in *SetSessionTtlReq, opts ...grpc.CallOption) (*SetSessionTtlResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSessionTtlResp)
+	err := c.cc.Invoke(ctx, Message_SetSessionTtl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) SetSessionEncryption(ctx context.Context, in *SetSessionEncryptionReq, opts ...grpc.CallOption) (*SetSessionEncryptionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSessionEncryptionResp)
+	err := c.cc.Invoke(ctx, Message_SetSessionEncryption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ForwardMessage(ctx context.Context, in *ForwardMessageReq, opts ...grpc.CallOption) (*ForwardMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForwardMessageResp)
+	err := c.cc.Invoke(ctx, Message_ForwardMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetMergedForward(ctx context.Context, in *GetMergedForwardReq, opts ...grpc.CallOption) (*GetMergedForwardResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMergedForwardResp)
+	err := c.cc.Invoke(ctx, Message_GetMergedForward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) CreateBroadcast(ctx context.Context, in *CreateBroadcastReq, opts ...grpc.CallOption) (*CreateBroadcastResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBroadcastResp)
+	err := c.cc.Invoke(ctx, Message_CreateBroadcast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetBroadcastStats(ctx context.Context, in *GetBroadcastStatsReq, opts ...grpc.CallOption) (*GetBroadcastStatsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBroadcastStatsResp)
+	err := c.cc.Invoke(ctx, Message_GetBroadcastStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListBroadcast(ctx context.Context, in *ListBroadcastReq, opts ...grpc.CallOption) (*ListBroadcastResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBroadcastResp)
+	err := c.cc.Invoke(ctx, Message_ListBroadcast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ReadBroadcast(ctx context.Context, in *ReadBroadcastReq, opts ...grpc.CallOption) (*ReadBroadcastResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadBroadcastResp)
+	err := c.cc.Invoke(ctx, Message_ReadBroadcast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateWebhookResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResp)
+	err := c.cc.Invoke(ctx, Message_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListWebhook(ctx context.Context, in *ListWebhookReq, opts ...grpc.CallOption) (*ListWebhookResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookResp)
+	err := c.cc.Invoke(ctx, Message_ListWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*DeleteWebhookResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResp)
+	err := c.cc.Invoke(ctx, Message_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListWebhookDelivery(ctx context.Context, in *ListWebhookDeliveryReq, opts ...grpc.CallOption) (*ListWebhookDeliveryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveryResp)
+	err := c.cc.Invoke(ctx, Message_ListWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) CardCallback(ctx context.Context, in *CardCallbackReq, opts ...grpc.CallOption) (*CardCallbackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardCallbackResp)
+	err := c.cc.Invoke(ctx, Message_CardCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) UpdateCard(ctx context.Context, in *UpdateCardReq, opts ...grpc.CallOption) (*UpdateCardResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCardResp)
+	err := c.cc.Invoke(ctx, Message_UpdateCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) CreateExport(ctx context.Context, in *CreateExportReq, opts ...grpc.CallOption) (*CreateExportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExportResp)
+	err := c.cc.Invoke(ctx, Message_CreateExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetExport(ctx context.Context, in *GetExportReq, opts ...grpc.CallOption) (*GetExportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExportResp)
+	err := c.cc.Invoke(ctx, Message_GetExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) DownloadExport(ctx context.Context, in *DownloadExportReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Message_ServiceDesc.Streams[0], Message_DownloadExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadExportReq, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Message_DownloadExportClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *messageClient) GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryReq, opts ...grpc.CallOption) (*GetUnreadSummaryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadSummaryResp)
+	err := c.cc.Invoke(ctx, Message_GetUnreadSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServer is the server API for Message service.
 // All implementations must embed UnimplementedMessageServer
 // for forward compatibility.
@@ -273,6 +630,35 @@ type MessageServer interface {
 	SearchGroup(context.Context, *SearchGroupReq) (*SearchGroupResp, error)
 	ListGroupApply(context.Context, *ListGroupApplyReq) (*ListGroupApplyResp, error)
 	CreateSession(context.Context, *CreateSessionReq) (*CreateSessionResp, error)
+	ListMessageReader(context.Context, *ListMessageReaderReq) (*ListMessageReaderResp, error)
+	ListHistory(context.Context, *ListHistoryReq) (*ListHistoryResp, error)
+	SearchMessage(context.Context, *SearchMessageReq) (*SearchMessageResp, error)
+	PinSession(context.Context, *PinSessionReq) (*PinSessionResp, error)
+	MuteSession(context.Context, *MuteSessionReq) (*MuteSessionResp, error)
+	ArchiveSession(context.Context, *ArchiveSessionReq) (*ArchiveSessionResp, error)
+	DeleteMessage(context.Context, *DeleteMessageReq) (*DeleteMessageResp, error)
+	ScheduleMessage(context.Context, *ScheduleMessageReq) (*ScheduleMessageResp, error)
+	ListScheduledMessage(context.Context, *ListScheduledMessageReq) (*ListScheduledMessageResp, error)
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageReq) (*CancelScheduledMessageResp, error)
+	RescheduleMessage(context.Context, *RescheduleMessageReq) (*RescheduleMessageResp, error)
+	SetSessionTtl(context.Context, *SetSessionTtlReq) (*SetSessionTtlResp, error)
+	SetSessionEncryption(context.Context, *SetSessionEncryptionReq) (*SetSessionEncryptionResp, error)
+	ForwardMessage(context.Context, *ForwardMessageReq) (*ForwardMessageResp, error)
+	GetMergedForward(context.Context, *GetMergedForwardReq) (*GetMergedForwardResp, error)
+	CreateBroadcast(context.Context, *CreateBroadcastReq) (*CreateBroadcastResp, error)
+	GetBroadcastStats(context.Context, *GetBroadcastStatsReq) (*GetBroadcastStatsResp, error)
+	ListBroadcast(context.Context, *ListBroadcastReq) (*ListBroadcastResp, error)
+	ReadBroadcast(context.Context, *ReadBroadcastReq) (*ReadBroadcastResp, error)
+	CreateWebhook(context.Context, *CreateWebhookReq) (*CreateWebhookResp, error)
+	ListWebhook(context.Context, *ListWebhookReq) (*ListWebhookResp, error)
+	DeleteWebhook(context.Context, *DeleteWebhookReq) (*DeleteWebhookResp, error)
+	ListWebhookDelivery(context.Context, *ListWebhookDeliveryReq) (*ListWebhookDeliveryResp, error)
+	CardCallback(context.Context, *CardCallbackReq) (*CardCallbackResp, error)
+	UpdateCard(context.Context, *UpdateCardReq) (*UpdateCardResp, error)
+	CreateExport(context.Context, *CreateExportReq) (*CreateExportResp, error)
+	GetExport(context.Context, *GetExportReq) (*GetExportResp, error)
+	DownloadExport(*DownloadExportReq, grpc.ServerStreamingServer[ExportChunk]) error
+	GetUnreadSummary(context.Context, *GetUnreadSummaryReq) (*GetUnreadSummaryResp, error)
 	mustEmbedUnimplementedMessageServer()
 }
 
@@ -337,6 +723,93 @@ func (UnimplementedMessageServer) ListGroupApply(context.Context, *ListGroupAppl
 func (UnimplementedMessageServer) CreateSession(context.Context, *CreateSessionReq) (*CreateSessionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
 }
+func (UnimplementedMessageServer) ListMessageReader(context.Context, *ListMessageReaderReq) (*ListMessageReaderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessageReader not implemented")
+}
+func (UnimplementedMessageServer) ListHistory(context.Context, *ListHistoryReq) (*ListHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHistory not implemented")
+}
+func (UnimplementedMessageServer) SearchMessage(context.Context, *SearchMessageReq) (*SearchMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessage not implemented")
+}
+func (UnimplementedMessageServer) PinSession(context.Context, *PinSessionReq) (*PinSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinSession not implemented")
+}
+func (UnimplementedMessageServer) MuteSession(context.Context, *MuteSessionReq) (*MuteSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteSession not implemented")
+}
+func (UnimplementedMessageServer) ArchiveSession(context.Context, *ArchiveSessionReq) (*ArchiveSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSession not implemented")
+}
+func (UnimplementedMessageServer) DeleteMessage(context.Context, *DeleteMessageReq) (*DeleteMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedMessageServer) ScheduleMessage(context.Context, *ScheduleMessageReq) (*ScheduleMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMessage not implemented")
+}
+func (UnimplementedMessageServer) ListScheduledMessage(context.Context, *ListScheduledMessageReq) (*ListScheduledMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledMessage not implemented")
+}
+func (UnimplementedMessageServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageReq) (*CancelScheduledMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedMessageServer) RescheduleMessage(context.Context, *RescheduleMessageReq) (*RescheduleMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMessage not implemented")
+}
+func (UnimplementedMessageServer) SetSessionTtl(context.Context, *SetSessionTtlReq) (*SetSessionTtlResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSessionTtl not implemented")
+}
+func (UnimplementedMessageServer) SetSessionEncryption(context.Context, *SetSessionEncryptionReq) (*SetSessionEncryptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSessionEncryption not implemented")
+}
+func (UnimplementedMessageServer) ForwardMessage(context.Context, *ForwardMessageReq) (*ForwardMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardMessage not implemented")
+}
+func (UnimplementedMessageServer) GetMergedForward(context.Context, *GetMergedForwardReq) (*GetMergedForwardResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMergedForward not implemented")
+}
+func (UnimplementedMessageServer) CreateBroadcast(context.Context, *CreateBroadcastReq) (*CreateBroadcastResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBroadcast not implemented")
+}
+func (UnimplementedMessageServer) GetBroadcastStats(context.Context, *GetBroadcastStatsReq) (*GetBroadcastStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcastStats not implemented")
+}
+func (UnimplementedMessageServer) ListBroadcast(context.Context, *ListBroadcastReq) (*ListBroadcastResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBroadcast not implemented")
+}
+func (UnimplementedMessageServer) ReadBroadcast(context.Context, *ReadBroadcastReq) (*ReadBroadcastResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadBroadcast not implemented")
+}
+func (UnimplementedMessageServer) CreateWebhook(context.Context, *CreateWebhookReq) (*CreateWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedMessageServer) ListWebhook(context.Context, *ListWebhookReq) (*ListWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhook not implemented")
+}
+func (UnimplementedMessageServer) DeleteWebhook(context.Context, *DeleteWebhookReq) (*DeleteWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedMessageServer) ListWebhookDelivery(context.Context, *ListWebhookDeliveryReq) (*ListWebhookDeliveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDelivery not implemented")
+}
+func (UnimplementedMessageServer) CardCallback(context.Context, *CardCallbackReq) (*CardCallbackResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CardCallback not implemented")
+}
+func (UnimplementedMessageServer) UpdateCard(context.Context, *UpdateCardReq) (*UpdateCardResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCard not implemented")
+}
+func (UnimplementedMessageServer) CreateExport(context.Context, *CreateExportReq) (*CreateExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExport not implemented")
+}
+func (UnimplementedMessageServer) GetExport(context.Context, *GetExportReq) (*GetExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExport not implemented")
+}
+func (UnimplementedMessageServer) DownloadExport(*DownloadExportReq, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadExport not implemented")
+}
+func (UnimplementedMessageServer) GetUnreadSummary(context.Context, *GetUnreadSummaryReq) (*GetUnreadSummaryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadSummary not implemented")
+}
 func (UnimplementedMessageServer) mustEmbedUnimplementedMessageServer() {}
 func (UnimplementedMessageServer) testEmbeddedByValue()                 {}
 
@@ -682,26 +1155,541 @@ func _Message_CreateSession_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// Message_ServiceDesc is the grpc.ServiceDesc for Message service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Message_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "message.Message",
-	HandlerType: (*MessageServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListSession",
-			Handler:    _Message_ListSession_Handler,
-		},
-		{
-			MethodName: "SendMessage",
-			Handler:    _Message_SendMessage_Handler,
-		},
-		{
-			MethodName: "AckMessage",
-			Handler:    _Message_AckMessage_Handler,
-		},
-		{
+func _Message_ListMessageReader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessageReaderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListMessageReader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ListMessageReader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListMessageReader(ctx, req.(*ListMessageReaderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ListHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListHistory(ctx, req.(*ListHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_SearchMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).SearchMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_SearchMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).SearchMessage(ctx, req.(*SearchMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_PinSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).PinSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_PinSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).PinSession(ctx, req.(*PinSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_MuteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).MuteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_MuteSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).MuteSession(ctx, req.(*MuteSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ArchiveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ArchiveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ArchiveSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ArchiveSession(ctx, req.(*ArchiveSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_DeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).DeleteMessage(ctx, req.(*DeleteMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ScheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ScheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ScheduleMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ScheduleMessage(ctx, req.(*ScheduleMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ListScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListScheduledMessage(ctx, req.(*ListScheduledMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_CancelScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_RescheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).RescheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_RescheduleMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).RescheduleMessage(ctx, req.(*RescheduleMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_SetSessionTtl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSessionTtlReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).SetSessionTtl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_SetSessionTtl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).SetSessionTtl(ctx, req.(*SetSessionTtlReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_SetSessionEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSessionEncryptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).SetSessionEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_SetSessionEncryption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).SetSessionEncryption(ctx, req.(*SetSessionEncryptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ForwardMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ForwardMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ForwardMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ForwardMessage(ctx, req.(*ForwardMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetMergedForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMergedForwardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetMergedForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_GetMergedForward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetMergedForward(ctx, req.(*GetMergedForwardReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_CreateBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBroadcastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CreateBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_CreateBroadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CreateBroadcast(ctx, req.(*CreateBroadcastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetBroadcastStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBroadcastStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetBroadcastStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_GetBroadcastStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetBroadcastStats(ctx, req.(*GetBroadcastStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBroadcastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ListBroadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListBroadcast(ctx, req.(*ListBroadcastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ReadBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadBroadcastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ReadBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ReadBroadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ReadBroadcast(ctx, req.(*ReadBroadcastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CreateWebhook(ctx, req.(*CreateWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ListWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListWebhook(ctx, req.(*ListWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).DeleteWebhook(ctx, req.(*DeleteWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_ListWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListWebhookDelivery(ctx, req.(*ListWebhookDeliveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_CardCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardCallbackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CardCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_CardCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CardCallback(ctx, req.(*CardCallbackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_UpdateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).UpdateCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_UpdateCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).UpdateCard(ctx, req.(*UpdateCardReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_CreateExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CreateExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_CreateExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CreateExport(ctx, req.(*CreateExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_GetExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetExport(ctx, req.(*GetExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_DownloadExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadExportReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessageServer).DownloadExport(m, &grpc.GenericServerStream[DownloadExportReq, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Message_DownloadExportServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _Message_GetUnreadSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadSummaryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetUnreadSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_GetUnreadSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetUnreadSummary(ctx, req.(*GetUnreadSummaryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Message_ServiceDesc is the grpc.ServiceDesc for Message service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Message_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "message.Message",
+	HandlerType: (*MessageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSession",
+			Handler:    _Message_ListSession_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _Message_SendMessage_Handler,
+		},
+		{
+			MethodName: "AckMessage",
+			Handler:    _Message_AckMessage_Handler,
+		},
+		{
 			MethodName: "DeleteUserSession",
 			Handler:    _Message_DeleteUserSession_Handler,
 		},
@@ -761,7 +1749,125 @@ var Message_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSession",
 			Handler:    _Message_CreateSession_Handler,
 		},
+		{
+			MethodName: "ListMessageReader",
+			Handler:    _Message_ListMessageReader_Handler,
+		},
+		{
+			MethodName: "ListHistory",
+			Handler:    _Message_ListHistory_Handler,
+		},
+		{
+			MethodName: "SearchMessage",
+			Handler:    _Message_SearchMessage_Handler,
+		},
+		{
+			MethodName: "PinSession",
+			Handler:    _Message_PinSession_Handler,
+		},
+		{
+			MethodName: "MuteSession",
+			Handler:    _Message_MuteSession_Handler,
+		},
+		{
+			MethodName: "ArchiveSession",
+			Handler:    _Message_ArchiveSession_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _Message_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "ScheduleMessage",
+			Handler:    _Message_ScheduleMessage_Handler,
+		},
+		{
+			MethodName: "ListScheduledMessage",
+			Handler:    _Message_ListScheduledMessage_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _Message_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "RescheduleMessage",
+			Handler:    _Message_RescheduleMessage_Handler,
+		},
+		{
+			MethodName: "SetSessionTtl",
+			Handler:    _Message_SetSessionTtl_Handler,
+		},
+		{
+			MethodName: "SetSessionEncryption",
+			Handler:    _Message_SetSessionEncryption_Handler,
+		},
+		{
+			MethodName: "ForwardMessage",
+			Handler:    _Message_ForwardMessage_Handler,
+		},
+		{
+			MethodName: "GetMergedForward",
+			Handler:    _Message_GetMergedForward_Handler,
+		},
+		{
+			MethodName: "CreateBroadcast",
+			Handler:    _Message_CreateBroadcast_Handler,
+		},
+		{
+			MethodName: "GetBroadcastStats",
+			Handler:    _Message_GetBroadcastStats_Handler,
+		},
+		{
+			MethodName: "ListBroadcast",
+			Handler:    _Message_ListBroadcast_Handler,
+		},
+		{
+			MethodName: "ReadBroadcast",
+			Handler:    _Message_ReadBroadcast_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Message_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhook",
+			Handler:    _Message_ListWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Message_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDelivery",
+			Handler:    _Message_ListWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "CardCallback",
+			Handler:    _Message_CardCallback_Handler,
+		},
+		{
+			MethodName: "UpdateCard",
+			Handler:    _Message_UpdateCard_Handler,
+		},
+		{
+			MethodName: "CreateExport",
+			Handler:    _Message_CreateExport_Handler,
+		},
+		{
+			MethodName: "GetExport",
+			Handler:    _Message_GetExport_Handler,
+		},
+		{
+			MethodName: "GetUnreadSummary",
+			Handler:    _Message_GetUnreadSummary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadExport",
+			Handler:       _Message_DownloadExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/message/message.proto",
 }
@@ -19,21 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	User_Register_FullMethodName         = "/user.User/Register"
-	User_Login_FullMethodName            = "/user.User/Login"
-	User_UserInfo_FullMethodName         = "/user.User/UserInfo"
-	User_UpdateInfo_FullMethodName       = "/user.User/UpdateInfo"
-	User_Heartbeat_FullMethodName        = "/user.User/Heartbeat"
-	User_Connect_FullMethodName          = "/user.User/Connect"
-	User_DisConnect_FullMethodName       = "/user.User/DisConnect"
-	User_FriendApply_FullMethodName      = "/user.User/FriendApply"
-	User_HandleApply_FullMethodName      = "/user.User/HandleApply"
-	User_ListApply_FullMethodName        = "/user.User/ListApply"
-	User_ListFriends_FullMethodName      = "/user.User/ListFriends"
-	User_DeleteFriend_FullMethodName     = "/user.User/DeleteFriend"
-	User_IsFriend_FullMethodName         = "/user.User/IsFriend"
-	User_SearchUser_FullMethodName       = "/user.User/SearchUser"
-	User_UpdateFriendInfo_FullMethodName = "/user.User/UpdateFriendInfo"
+	User_Register_FullMethodName             = "/user.User/Register"
+	User_Login_FullMethodName                = "/user.User/Login"
+	User_UserInfo_FullMethodName             = "/user.User/UserInfo"
+	User_BatchUserInfo_FullMethodName        = "/user.User/BatchUserInfo"
+	User_ListUserIds_FullMethodName          = "/user.User/ListUserIds"
+	User_UpdateInfo_FullMethodName           = "/user.User/UpdateInfo"
+	User_Heartbeat_FullMethodName            = "/user.User/Heartbeat"
+	User_Connect_FullMethodName              = "/user.User/Connect"
+	User_DisConnect_FullMethodName           = "/user.User/DisConnect"
+	User_FriendApply_FullMethodName          = "/user.User/FriendApply"
+	User_HandleApply_FullMethodName          = "/user.User/HandleApply"
+	User_ListApply_FullMethodName            = "/user.User/ListApply"
+	User_ListFriends_FullMethodName          = "/user.User/ListFriends"
+	User_DeleteFriend_FullMethodName         = "/user.User/DeleteFriend"
+	User_IsFriend_FullMethodName             = "/user.User/IsFriend"
+	User_SearchUser_FullMethodName           = "/user.User/SearchUser"
+	User_UpdateFriendInfo_FullMethodName     = "/user.User/UpdateFriendInfo"
+	User_CreateBot_FullMethodName            = "/user.User/CreateBot"
+	User_ListBot_FullMethodName              = "/user.User/ListBot"
+	User_CreateBotToken_FullMethodName       = "/user.User/CreateBotToken"
+	User_ListBotToken_FullMethodName         = "/user.User/ListBotToken"
+	User_RevokeBotToken_FullMethodName       = "/user.User/RevokeBotToken"
+	User_BotAuth_FullMethodName              = "/user.User/BotAuth"
+	User_GetPrivacy_FullMethodName           = "/user.User/GetPrivacy"
+	User_UpdatePrivacy_FullMethodName        = "/user.User/UpdatePrivacy"
+	User_BlockUser_FullMethodName            = "/user.User/BlockUser"
+	User_UnblockUser_FullMethodName          = "/user.User/UnblockUser"
+	User_ListBlocked_FullMethodName          = "/user.User/ListBlocked"
+	User_CheckBlocked_FullMethodName         = "/user.User/CheckBlocked"
+	User_ListHiders_FullMethodName           = "/user.User/ListHiders"
+	User_UploadDeviceKeys_FullMethodName     = "/user.User/UploadDeviceKeys"
+	User_GetPrekeyBundle_FullMethodName      = "/user.User/GetPrekeyBundle"
+	User_RevokeDevice_FullMethodName         = "/user.User/RevokeDevice"
+	User_ListDevices_FullMethodName          = "/user.User/ListDevices"
+	User_ListDeviceIds_FullMethodName        = "/user.User/ListDeviceIds"
+	User_RegisterPushToken_FullMethodName    = "/user.User/RegisterPushToken"
+	User_UnregisterPushToken_FullMethodName  = "/user.User/UnregisterPushToken"
+	User_GetPushSetting_FullMethodName       = "/user.User/GetPushSetting"
+	User_UpdatePushSetting_FullMethodName    = "/user.User/UpdatePushSetting"
+	User_GetPushTargets_FullMethodName       = "/user.User/GetPushTargets"
+	User_InvalidatePushTokens_FullMethodName = "/user.User/InvalidatePushTokens"
 )
 
 // UserClient is the client API for User service.
@@ -43,6 +69,8 @@ type UserClient interface {
 	Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterResp, error)
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 	UserInfo(ctx context.Context, in *UserInfoReq, opts ...grpc.CallOption) (*UserInfoResp, error)
+	BatchUserInfo(ctx context.Context, in *BatchUserInfoReq, opts ...grpc.CallOption) (*BatchUserInfoResp, error)
+	ListUserIds(ctx context.Context, in *ListUserIdsReq, opts ...grpc.CallOption) (*ListUserIdsResp, error)
 	UpdateInfo(ctx context.Context, in *UpdateInfoReq, opts ...grpc.CallOption) (*UpdateInfoResp, error)
 	Heartbeat(ctx context.Context, in *HeartBeatReq, opts ...grpc.CallOption) (*HeartBeatResp, error)
 	Connect(ctx context.Context, in *ConnectReq, opts ...grpc.CallOption) (*ConnectResp, error)
@@ -55,6 +83,34 @@ type UserClient interface {
 	IsFriend(ctx context.Context, in *IsFriendReq, opts ...grpc.CallOption) (*IsFriendResp, error)
 	SearchUser(ctx context.Context, in *SearchUserReq, opts ...grpc.CallOption) (*SearchUserResp, error)
 	UpdateFriendInfo(ctx context.Context, in *UpdateFriendInfoReq, opts ...grpc.CallOption) (*UpdateFriendInfoResp, error)
+	CreateBot(ctx context.Context, in *CreateBotReq, opts ...grpc.CallOption) (*CreateBotResp, error)
+	ListBot(ctx context.Context, in *ListBotReq, opts ...grpc.CallOption) (*ListBotResp, error)
+	CreateBotToken(ctx context.Context, in *CreateBotTokenReq, opts ...grpc.CallOption) (*CreateBotTokenResp, error)
+	ListBotToken(ctx context.Context, in *ListBotTokenReq, opts ...grpc.CallOption) (*ListBotTokenResp, error)
+	RevokeBotToken(ctx context.Context, in *RevokeBotTokenReq, opts ...grpc.CallOption) (*RevokeBotTokenResp, error)
+	// BotAuth 校验 token 并计入限流，网关的机器人路由每个请求调用一次
+	BotAuth(ctx context.Context, in *BotAuthReq, opts ...grpc.CallOption) (*BotAuthResp, error)
+	GetPrivacy(ctx context.Context, in *GetPrivacyReq, opts ...grpc.CallOption) (*GetPrivacyResp, error)
+	UpdatePrivacy(ctx context.Context, in *UpdatePrivacyReq, opts ...grpc.CallOption) (*UpdatePrivacyResp, error)
+	BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockUserResp, error)
+	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*UnblockUserResp, error)
+	ListBlocked(ctx context.Context, in *ListBlockedReq, opts ...grpc.CallOption) (*ListBlockedResp, error)
+	// CheckBlocked 和 ListHiders 走缓存，供消息服务在收发消息时调用
+	CheckBlocked(ctx context.Context, in *CheckBlockedReq, opts ...grpc.CallOption) (*CheckBlockedResp, error)
+	ListHiders(ctx context.Context, in *ListHidersReq, opts ...grpc.CallOption) (*ListHidersResp, error)
+	UploadDeviceKeys(ctx context.Context, in *UploadDeviceKeysReq, opts ...grpc.CallOption) (*UploadDeviceKeysResp, error)
+	GetPrekeyBundle(ctx context.Context, in *GetPrekeyBundleReq, opts ...grpc.CallOption) (*GetPrekeyBundleResp, error)
+	RevokeDevice(ctx context.Context, in *RevokeDeviceReq, opts ...grpc.CallOption) (*RevokeDeviceResp, error)
+	ListDevices(ctx context.Context, in *ListDevicesReq, opts ...grpc.CallOption) (*ListDevicesResp, error)
+	// 消息服务校验加密消息覆盖了双方全部设备
+	ListDeviceIds(ctx context.Context, in *ListDeviceIdsReq, opts ...grpc.CallOption) (*ListDeviceIdsResp, error)
+	RegisterPushToken(ctx context.Context, in *RegisterPushTokenReq, opts ...grpc.CallOption) (*RegisterPushTokenResp, error)
+	UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenReq, opts ...grpc.CallOption) (*UnregisterPushTokenResp, error)
+	GetPushSetting(ctx context.Context, in *GetPushSettingReq, opts ...grpc.CallOption) (*GetPushSettingResp, error)
+	UpdatePushSetting(ctx context.Context, in *UpdatePushSettingReq, opts ...grpc.CallOption) (*UpdatePushSettingResp, error)
+	// GetPushTargets 和 InvalidatePushTokens 供消息服务离线推送时调用
+	GetPushTargets(ctx context.Context, in *GetPushTargetsReq, opts ...grpc.CallOption) (*GetPushTargetsResp, error)
+	InvalidatePushTokens(ctx context.Context, in *InvalidatePushTokensReq, opts ...grpc.CallOption) (*InvalidatePushTokensResp, error)
 }
 
 type userClient struct {
@@ -95,6 +151,26 @@ func (c *userClient) UserInfo(ctx context.Context, in *UserInfoReq, opts ...grpc
 	return out, nil
 }
 
+func (c *userClient) BatchUserInfo(ctx context.Context, in *BatchUserInfoReq, opts ...grpc.CallOption) (*BatchUserInfoResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUserInfoResp)
+	err := c.cc.Invoke(ctx, User_BatchUserInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListUserIds(ctx context.Context, in *ListUserIdsReq, opts ...grpc.CallOption) (*ListUserIdsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserIdsResp)
+	err := c.cc.Invoke(ctx, User_ListUserIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) UpdateInfo(ctx context.Context, in *UpdateInfoReq, opts ...grpc.CallOption) (*UpdateInfoResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateInfoResp)
@@ -215,6 +291,246 @@ func (c *userClient) UpdateFriendInfo(ctx context.Context, in *UpdateFriendInfoR
 	return out, nil
 }
 
+func (c *userClient) CreateBot(ctx context.Context, in *CreateBotReq, opts ...grpc.CallOption) (*CreateBotResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBotResp)
+	err := c.cc.Invoke(ctx, User_CreateBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListBot(ctx context.Context, in *ListBotReq, opts ...grpc.CallOption) (*ListBotResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBotResp)
+	err := c.cc.Invoke(ctx, User_ListBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CreateBotToken(ctx context.Context, in *CreateBotTokenReq, opts ...grpc.CallOption) (*CreateBotTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBotTokenResp)
+	err := c.cc.Invoke(ctx, User_CreateBotToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListBotToken(ctx context.Context, in *ListBotTokenReq, opts ...grpc.CallOption) (*ListBotTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBotTokenResp)
+	err := c.cc.Invoke(ctx, User_ListBotToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeBotToken(ctx context.Context, in *RevokeBotTokenReq, opts ...grpc.CallOption) (*RevokeBotTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeBotTokenResp)
+	err := c.cc.Invoke(ctx, User_RevokeBotToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) BotAuth(ctx context.Context, in *BotAuthReq, opts ...grpc.CallOption) (*BotAuthResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BotAuthResp)
+	err := c.cc.Invoke(ctx, User_BotAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetPrivacy(ctx context.Context, in *GetPrivacyReq, opts ...grpc.CallOption) (*GetPrivacyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrivacyResp)
+	err := c.cc.Invoke(ctx, User_GetPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdatePrivacy(ctx context.Context, in *UpdatePrivacyReq, opts ...grpc.CallOption) (*UpdatePrivacyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePrivacyResp)
+	err := c.cc.Invoke(ctx, User_UpdatePrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResp)
+	err := c.cc.Invoke(ctx, User_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*UnblockUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResp)
+	err := c.cc.Invoke(ctx, User_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListBlocked(ctx context.Context, in *ListBlockedReq, opts ...grpc.CallOption) (*ListBlockedResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedResp)
+	err := c.cc.Invoke(ctx, User_ListBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CheckBlocked(ctx context.Context, in *CheckBlockedReq, opts ...grpc.CallOption) (*CheckBlockedResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckBlockedResp)
+	err := c.cc.Invoke(ctx, User_CheckBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListHiders(ctx context.Context, in *ListHidersReq, opts ...grpc.CallOption) (*ListHidersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHidersResp)
+	err := c.cc.Invoke(ctx, User_ListHiders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UploadDeviceKeys(ctx context.Context, in *UploadDeviceKeysReq, opts ...grpc.CallOption) (*UploadDeviceKeysResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadDeviceKeysResp)
+	err := c.cc.Invoke(ctx, User_UploadDeviceKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetPrekeyBundle(ctx context.Context, in *GetPrekeyBundleReq, opts ...grpc.CallOption) (*GetPrekeyBundleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrekeyBundleResp)
+	err := c.cc.Invoke(ctx, User_GetPrekeyBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeDevice(ctx context.Context, in *RevokeDeviceReq, opts ...grpc.CallOption) (*RevokeDeviceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeDeviceResp)
+	err := c.cc.Invoke(ctx, User_RevokeDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListDevices(ctx context.Context, in *ListDevicesReq, opts ...grpc.CallOption) (*ListDevicesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDevicesResp)
+	err := c.cc.Invoke(ctx, User_ListDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListDeviceIds(ctx context.Context, in *ListDeviceIdsReq, opts ...grpc.CallOption) (*ListDeviceIdsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeviceIdsResp)
+	err := c.cc.Invoke(ctx, User_ListDeviceIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RegisterPushToken(ctx context.Context, in *RegisterPushTokenReq, opts ...grpc.CallOption) (*RegisterPushTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPushTokenResp)
+	err := c.cc.Invoke(ctx, User_RegisterPushToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenReq, opts ...grpc.CallOption) (*UnregisterPushTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnregisterPushTokenResp)
+	err := c.cc.Invoke(ctx, User_UnregisterPushToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetPushSetting(ctx context.Context, in *GetPushSettingReq, opts ...grpc.CallOption) (*GetPushSettingResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPushSettingResp)
+	err := c.cc.Invoke(ctx, User_GetPushSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdatePushSetting(ctx context.Context, in *UpdatePushSettingReq, opts ...grpc.CallOption) (*UpdatePushSettingResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePushSettingResp)
+	err := c.cc.Invoke(ctx, User_UpdatePushSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetPushTargets(ctx context.Context, in *GetPushTargetsReq, opts ...grpc.CallOption) (*GetPushTargetsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPushTargetsResp)
+	err := c.cc.Invoke(ctx, User_GetPushTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) InvalidatePushTokens(ctx context.Context, in *InvalidatePushTokensReq, opts ...grpc.CallOption) (*InvalidatePushTokensResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidatePushTokensResp)
+	err := c.cc.Invoke(ctx, User_InvalidatePushTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
@@ -222,6 +538,8 @@ type UserServer interface {
 	Register(context.Context, *RegisterReq) (*RegisterResp, error)
 	Login(context.Context, *LoginReq) (*LoginResp, error)
 	UserInfo(context.Context, *UserInfoReq) (*UserInfoResp, error)
+	BatchUserInfo(context.Context, *BatchUserInfoReq) (*BatchUserInfoResp, error)
+	ListUserIds(context.Context, *ListUserIdsReq) (*ListUserIdsResp, error)
 	UpdateInfo(context.Context, *UpdateInfoReq) (*UpdateInfoResp, error)
 	Heartbeat(context.Context, *HeartBeatReq) (*HeartBeatResp, error)
 	Connect(context.Context, *ConnectReq) (*ConnectResp, error)
@@ -234,6 +552,34 @@ type UserServer interface {
 	IsFriend(context.Context, *IsFriendReq) (*IsFriendResp, error)
 	SearchUser(context.Context, *SearchUserReq) (*SearchUserResp, error)
 	UpdateFriendInfo(context.Context, *UpdateFriendInfoReq) (*UpdateFriendInfoResp, error)
+	CreateBot(context.Context, *CreateBotReq) (*CreateBotResp, error)
+	ListBot(context.Context, *ListBotReq) (*ListBotResp, error)
+	CreateBotToken(context.Context, *CreateBotTokenReq) (*CreateBotTokenResp, error)
+	ListBotToken(context.Context, *ListBotTokenReq) (*ListBotTokenResp, error)
+	RevokeBotToken(context.Context, *RevokeBotTokenReq) (*RevokeBotTokenResp, error)
+	// BotAuth 校验 token 并计入限流，网关的机器人路由每个请求调用一次
+	BotAuth(context.Context, *BotAuthReq) (*BotAuthResp, error)
+	GetPrivacy(context.Context, *GetPrivacyReq) (*GetPrivacyResp, error)
+	UpdatePrivacy(context.Context, *UpdatePrivacyReq) (*UpdatePrivacyResp, error)
+	BlockUser(context.Context, *BlockUserReq) (*BlockUserResp, error)
+	UnblockUser(context.Context, *UnblockUserReq) (*UnblockUserResp, error)
+	ListBlocked(context.Context, *ListBlockedReq) (*ListBlockedResp, error)
+	// CheckBlocked 和 ListHiders 走缓存，供消息服务在收发消息时调用
+	CheckBlocked(context.Context, *CheckBlockedReq) (*CheckBlockedResp, error)
+	ListHiders(context.Context, *ListHidersReq) (*ListHidersResp, error)
+	UploadDeviceKeys(context.Context, *UploadDeviceKeysReq) (*UploadDeviceKeysResp, error)
+	GetPrekeyBundle(context.Context, *GetPrekeyBundleReq) (*GetPrekeyBundleResp, error)
+	RevokeDevice(context.Context, *RevokeDeviceReq) (*RevokeDeviceResp, error)
+	ListDevices(context.Context, *ListDevicesReq) (*ListDevicesResp, error)
+	// 消息服务校验加密消息覆盖了双方全部设备
+	ListDeviceIds(context.Context, *ListDeviceIdsReq) (*ListDeviceIdsResp, error)
+	RegisterPushToken(context.Context, *RegisterPushTokenReq) (*RegisterPushTokenResp, error)
+	UnregisterPushToken(context.Context, *UnregisterPushTokenReq) (*UnregisterPushTokenResp, error)
+	GetPushSetting(context.Context, *GetPushSettingReq) (*GetPushSettingResp, error)
+	UpdatePushSetting(context.Context, *UpdatePushSettingReq) (*UpdatePushSettingResp, error)
+	// GetPushTargets 和 InvalidatePushTokens 供消息服务离线推送时调用
+	GetPushTargets(context.Context, *GetPushTargetsReq) (*GetPushTargetsResp, error)
+	InvalidatePushTokens(context.Context, *InvalidatePushTokensReq) (*InvalidatePushTokensResp, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -253,6 +599,12 @@ func (UnimplementedUserServer) Login(context.Context, *LoginReq) (*LoginResp, er
 func (UnimplementedUserServer) UserInfo(context.Context, *UserInfoReq) (*UserInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
 }
+func (UnimplementedUserServer) BatchUserInfo(context.Context, *BatchUserInfoReq) (*BatchUserInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUserInfo not implemented")
+}
+func (UnimplementedUserServer) ListUserIds(context.Context, *ListUserIdsReq) (*ListUserIdsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserIds not implemented")
+}
 func (UnimplementedUserServer) UpdateInfo(context.Context, *UpdateInfoReq) (*UpdateInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInfo not implemented")
 }
@@ -289,6 +641,78 @@ func (UnimplementedUserServer) SearchUser(context.Context, *SearchUserReq) (*Sea
 func (UnimplementedUserServer) UpdateFriendInfo(context.Context, *UpdateFriendInfoReq) (*UpdateFriendInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFriendInfo not implemented")
 }
+func (UnimplementedUserServer) CreateBot(context.Context, *CreateBotReq) (*CreateBotResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBot not implemented")
+}
+func (UnimplementedUserServer) ListBot(context.Context, *ListBotReq) (*ListBotResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBot not implemented")
+}
+func (UnimplementedUserServer) CreateBotToken(context.Context, *CreateBotTokenReq) (*CreateBotTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBotToken not implemented")
+}
+func (UnimplementedUserServer) ListBotToken(context.Context, *ListBotTokenReq) (*ListBotTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBotToken not implemented")
+}
+func (UnimplementedUserServer) RevokeBotToken(context.Context, *RevokeBotTokenReq) (*RevokeBotTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBotToken not implemented")
+}
+func (UnimplementedUserServer) BotAuth(context.Context, *BotAuthReq) (*BotAuthResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BotAuth not implemented")
+}
+func (UnimplementedUserServer) GetPrivacy(context.Context, *GetPrivacyReq) (*GetPrivacyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacy not implemented")
+}
+func (UnimplementedUserServer) UpdatePrivacy(context.Context, *UpdatePrivacyReq) (*UpdatePrivacyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrivacy not implemented")
+}
+func (UnimplementedUserServer) BlockUser(context.Context, *BlockUserReq) (*BlockUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedUserServer) UnblockUser(context.Context, *UnblockUserReq) (*UnblockUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedUserServer) ListBlocked(context.Context, *ListBlockedReq) (*ListBlockedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocked not implemented")
+}
+func (UnimplementedUserServer) CheckBlocked(context.Context, *CheckBlockedReq) (*CheckBlockedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBlocked not implemented")
+}
+func (UnimplementedUserServer) ListHiders(context.Context, *ListHidersReq) (*ListHidersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHiders not implemented")
+}
+func (UnimplementedUserServer) UploadDeviceKeys(context.Context, *UploadDeviceKeysReq) (*UploadDeviceKeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadDeviceKeys not implemented")
+}
+func (UnimplementedUserServer) GetPrekeyBundle(context.Context, *GetPrekeyBundleReq) (*GetPrekeyBundleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrekeyBundle not implemented")
+}
+func (UnimplementedUserServer) RevokeDevice(context.Context, *RevokeDeviceReq) (*RevokeDeviceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDevice not implemented")
+}
+func (UnimplementedUserServer) ListDevices(context.Context, *ListDevicesReq) (*ListDevicesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedUserServer) ListDeviceIds(context.Context, *ListDeviceIdsReq) (*ListDeviceIdsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeviceIds not implemented")
+}
+func (UnimplementedUserServer) RegisterPushToken(context.Context, *RegisterPushTokenReq) (*RegisterPushTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPushToken not implemented")
+}
+func (UnimplementedUserServer) UnregisterPushToken(context.Context, *UnregisterPushTokenReq) (*UnregisterPushTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterPushToken not implemented")
+}
+func (UnimplementedUserServer) GetPushSetting(context.Context, *GetPushSettingReq) (*GetPushSettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushSetting not implemented")
+}
+func (UnimplementedUserServer) UpdatePushSetting(context.Context, *UpdatePushSettingReq) (*UpdatePushSettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePushSetting not implemented")
+}
+func (UnimplementedUserServer) GetPushTargets(context.Context, *GetPushTargetsReq) (*GetPushTargetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushTargets not implemented")
+}
+func (UnimplementedUserServer) InvalidatePushTokens(context.Context, *InvalidatePushTokensReq) (*InvalidatePushTokensResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidatePushTokens not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
@@ -364,6 +788,42 @@ func _User_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_BatchUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUserInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BatchUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_BatchUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BatchUserInfo(ctx, req.(*BatchUserInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListUserIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserIdsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListUserIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListUserIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListUserIds(ctx, req.(*ListUserIdsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_UpdateInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateInfoReq)
 	if err := dec(in); err != nil {
@@ -580,28 +1040,468 @@ func _User_UpdateFriendInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// User_ServiceDesc is the grpc.ServiceDesc for User service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var User_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "user.User",
-	HandlerType: (*UserServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Register",
-			Handler:    _User_Register_Handler,
-		},
-		{
-			MethodName: "Login",
-			Handler:    _User_Login_Handler,
-		},
-		{
-			MethodName: "UserInfo",
-			Handler:    _User_UserInfo_Handler,
-		},
-		{
-			MethodName: "UpdateInfo",
-			Handler:    _User_UpdateInfo_Handler,
+func _User_CreateBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateBot(ctx, req.(*CreateBotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListBot(ctx, req.(*ListBotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CreateBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateBotToken(ctx, req.(*CreateBotTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBotTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListBotToken(ctx, req.(*ListBotTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBotTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeBotToken(ctx, req.(*RevokeBotTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_BotAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BotAuthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BotAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_BotAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BotAuth(ctx, req.(*BotAuthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetPrivacy(ctx, req.(*GetPrivacyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdatePrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrivacyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdatePrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdatePrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdatePrivacy(ctx, req.(*UpdatePrivacyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BlockUser(ctx, req.(*BlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnblockUser(ctx, req.(*UnblockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListBlocked(ctx, req.(*ListBlockedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CheckBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBlockedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CheckBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CheckBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CheckBlocked(ctx, req.(*CheckBlockedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListHiders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHidersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListHiders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListHiders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListHiders(ctx, req.(*ListHidersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UploadDeviceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDeviceKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UploadDeviceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UploadDeviceKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UploadDeviceKeys(ctx, req.(*UploadDeviceKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetPrekeyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrekeyBundleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetPrekeyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetPrekeyBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetPrekeyBundle(ctx, req.(*GetPrekeyBundleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeDevice(ctx, req.(*RevokeDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListDevices(ctx, req.(*ListDevicesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListDeviceIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceIdsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListDeviceIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListDeviceIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListDeviceIds(ctx, req.(*ListDeviceIdsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RegisterPushToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPushTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RegisterPushToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RegisterPushToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RegisterPushToken(ctx, req.(*RegisterPushTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnregisterPushToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterPushTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnregisterPushToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UnregisterPushToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnregisterPushToken(ctx, req.(*UnregisterPushTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetPushSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushSettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetPushSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetPushSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetPushSetting(ctx, req.(*GetPushSettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdatePushSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePushSettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdatePushSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdatePushSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdatePushSetting(ctx, req.(*UpdatePushSettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetPushTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushTargetsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetPushTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetPushTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetPushTargets(ctx, req.(*GetPushTargetsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_InvalidatePushTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidatePushTokensReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).InvalidatePushTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_InvalidatePushTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).InvalidatePushTokens(ctx, req.(*InvalidatePushTokensReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// User_ServiceDesc is the grpc.ServiceDesc for User service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var User_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.User",
+	HandlerType: (*UserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _User_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _User_Login_Handler,
+		},
+		{
+			MethodName: "UserInfo",
+			Handler:    _User_UserInfo_Handler,
+		},
+		{
+			MethodName: "BatchUserInfo",
+			Handler:    _User_BatchUserInfo_Handler,
+		},
+		{
+			MethodName: "ListUserIds",
+			Handler:    _User_ListUserIds_Handler,
+		},
+		{
+			MethodName: "UpdateInfo",
+			Handler:    _User_UpdateInfo_Handler,
 		},
 		{
 			MethodName: "Heartbeat",
@@ -647,6 +1547,102 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateFriendInfo",
 			Handler:    _User_UpdateFriendInfo_Handler,
 		},
+		{
+			MethodName: "CreateBot",
+			Handler:    _User_CreateBot_Handler,
+		},
+		{
+			MethodName: "ListBot",
+			Handler:    _User_ListBot_Handler,
+		},
+		{
+			MethodName: "CreateBotToken",
+			Handler:    _User_CreateBotToken_Handler,
+		},
+		{
+			MethodName: "ListBotToken",
+			Handler:    _User_ListBotToken_Handler,
+		},
+		{
+			MethodName: "RevokeBotToken",
+			Handler:    _User_RevokeBotToken_Handler,
+		},
+		{
+			MethodName: "BotAuth",
+			Handler:    _User_BotAuth_Handler,
+		},
+		{
+			MethodName: "GetPrivacy",
+			Handler:    _User_GetPrivacy_Handler,
+		},
+		{
+			MethodName: "UpdatePrivacy",
+			Handler:    _User_UpdatePrivacy_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _User_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _User_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlocked",
+			Handler:    _User_ListBlocked_Handler,
+		},
+		{
+			MethodName: "CheckBlocked",
+			Handler:    _User_CheckBlocked_Handler,
+		},
+		{
+			MethodName: "ListHiders",
+			Handler:    _User_ListHiders_Handler,
+		},
+		{
+			MethodName: "UploadDeviceKeys",
+			Handler:    _User_UploadDeviceKeys_Handler,
+		},
+		{
+			MethodName: "GetPrekeyBundle",
+			Handler:    _User_GetPrekeyBundle_Handler,
+		},
+		{
+			MethodName: "RevokeDevice",
+			Handler:    _User_RevokeDevice_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _User_ListDevices_Handler,
+		},
+		{
+			MethodName: "ListDeviceIds",
+			Handler:    _User_ListDeviceIds_Handler,
+		},
+		{
+			MethodName: "RegisterPushToken",
+			Handler:    _User_RegisterPushToken_Handler,
+		},
+		{
+			MethodName: "UnregisterPushToken",
+			Handler:    _User_UnregisterPushToken_Handler,
+		},
+		{
+			MethodName: "GetPushSetting",
+			Handler:    _User_GetPushSetting_Handler,
+		},
+		{
+			MethodName: "UpdatePushSetting",
+			Handler:    _User_UpdatePushSetting_Handler,
+		},
+		{
+			MethodName: "GetPushTargets",
+			Handler:    _User_GetPushTargets_Handler,
+		},
+		{
+			MethodName: "InvalidatePushTokens",
+			Handler:    _User_InvalidatePushTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/user/user.proto",
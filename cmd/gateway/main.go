@@ -74,6 +74,15 @@ func main() {
 	uploadApi := logic.NewUploadApi(s)
 	uploadApi.RegisterRouter(api)
 
+	adminApi := logic.NewAdminApi(s)
+	adminApi.RegisterRouter(api)
+
+	webhookApi := logic.NewWebhookApi(s)
+	webhookApi.RegisterRouter(api)
+
+	botApi := logic.NewBotApi(s)
+	botApi.RegisterRouter(api)
+
 	if c.Server.Addr == "" {
 		c.Server.Addr = "0.0.0.0:9000"
 	}
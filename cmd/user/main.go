@@ -89,7 +89,7 @@ func main() {
 		accessClient = access.NewAccessClient(conn)
 	}
 
-	svc := server.NewServer(rdb, db, kafkaWriter, accessClient)
+	svc := server.NewServer(c, rdb, db, kafkaWriter, accessClient)
 
 	c.RPC.Trace = c.Trace.Enable
 	grpcSvc := rpc.NewGrpcServer(&c.RPC)
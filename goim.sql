@@ -15,6 +15,198 @@
 /*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;
 /*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */;
 
+--
+-- Table structure for table `bot_token`
+--
+
+DROP TABLE IF EXISTS `bot_token`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `bot_token` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `bot_id` bigint NOT NULL,
+  `name` varchar(50) NOT NULL DEFAULT '',
+  `token_hash` char(64) NOT NULL,
+  `last_used_at` timestamp NULL DEFAULT NULL,
+  `revoked_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `token_hash_idx` (`token_hash`),
+  KEY `bot_idx` (`bot_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `broadcast`
+--
+
+DROP TABLE IF EXISTS `broadcast`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `broadcast` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `operator_id` bigint NOT NULL,
+  `title` varchar(100) NOT NULL,
+  `content` text NOT NULL,
+  `segment` varchar(20) NOT NULL,
+  `registered_after` timestamp NULL DEFAULT NULL,
+  `group_ids` varchar(2000) NOT NULL DEFAULT '',
+  `status` varchar(10) NOT NULL DEFAULT 'pending',
+  `last_user_id` bigint NOT NULL DEFAULT '0',
+  `total_count` bigint NOT NULL DEFAULT '0',
+  `delivered_count` bigint NOT NULL DEFAULT '0',
+  `read_count` bigint NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `status_idx` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `broadcast_receipt`
+--
+
+DROP TABLE IF EXISTS `broadcast_receipt`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `broadcast_receipt` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `broadcast_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `delivered_at` timestamp NULL DEFAULT NULL,
+  `read_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `broadcast_user_idx` (`broadcast_id`,`user_id`),
+  KEY `user_idx` (`user_id`,`broadcast_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `conversation`
+--
+
+DROP TABLE IF EXISTS `conversation`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `conversation` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `kind` varchar(10) NOT NULL,
+  `from_id` bigint NOT NULL,
+  `to_id` bigint NOT NULL,
+  `seq` bigint NOT NULL DEFAULT '0',
+  `last_msg_id` bigint NOT NULL DEFAULT '0',
+  `ttl` bigint NOT NULL DEFAULT '0',
+  `ttl_mode` varchar(10) NOT NULL DEFAULT '',
+  `encrypted` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `conversation_idx` (`kind`,`from_id`,`to_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `device_key`
+--
+
+DROP TABLE IF EXISTS `device_key`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `device_key` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `device_id` varchar(64) NOT NULL,
+  `identity_key` varchar(255) NOT NULL,
+  `signed_prekey_id` bigint NOT NULL DEFAULT '0',
+  `signed_prekey` varchar(255) NOT NULL,
+  `signed_prekey_sig` varchar(255) NOT NULL,
+  `revoked_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_device_idx` (`user_id`,`device_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `export_chunk`
+--
+
+DROP TABLE IF EXISTS `export_chunk`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `export_chunk` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `job_id` bigint NOT NULL,
+  `idx` int NOT NULL,
+  `data` mediumblob NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `job_idx` (`job_id`,`idx`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `export_job`
+--
+
+DROP TABLE IF EXISTS `export_job`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `export_job` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `session_id` bigint NOT NULL,
+  `kind` varchar(10) NOT NULL,
+  `owner_id` bigint NOT NULL,
+  `to_id` bigint NOT NULL,
+  `format` varchar(10) NOT NULL,
+  `start_time` timestamp NULL DEFAULT NULL,
+  `end_time` timestamp NULL DEFAULT NULL,
+  `compliance` tinyint(1) NOT NULL DEFAULT '0',
+  `status` varchar(10) NOT NULL DEFAULT 'pending',
+  `message_count` bigint NOT NULL DEFAULT '0',
+  `file_size` bigint NOT NULL DEFAULT '0',
+  `error` varchar(255) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `status_idx` (`status`,`updated_at`),
+  KEY `user_idx` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `forward_bundle`
+--
+
+DROP TABLE IF EXISTS `forward_bundle`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `forward_bundle` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `title` varchar(100) NOT NULL DEFAULT '',
+  `items` mediumtext NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `friend_apply`
 --
@@ -112,6 +304,7 @@ CREATE TABLE `group_member` (
   `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   `deleted_at` timestamp NULL DEFAULT NULL,
   `session_id` bigint NOT NULL,
+  `role` varchar(10) NOT NULL DEFAULT 'member',
   PRIMARY KEY (`id`),
   KEY `group_id` (`group_id`),
   KEY `user_id` (`user_id`)
@@ -129,18 +322,215 @@ CREATE TABLE `message` (
   `id` bigint NOT NULL AUTO_INCREMENT,
   `from_id` bigint NOT NULL,
   `to_id` bigint NOT NULL,
-  `content` varchar(500) NOT NULL,
+  `content` text NOT NULL,
   `seq` bigint NOT NULL,
+  `client_seq` bigint NOT NULL DEFAULT '0',
+  `client_msg_id` varchar(64) DEFAULT NULL,
   `kind` varchar(10) NOT NULL,
+  `type` varchar(20) NOT NULL DEFAULT 'text',
+  `revoked_at` timestamp NULL DEFAULT NULL,
+  `revoked_by` bigint NOT NULL DEFAULT '0',
+  `ttl` bigint NOT NULL DEFAULT '0',
+  `expire_at` timestamp NULL DEFAULT NULL,
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `deleted_at` timestamp NULL DEFAULT NULL,
   `updated_at` timestamp NULL DEFAULT NULL,
   PRIMARY KEY (`id`),
+  UNIQUE KEY `client_msg_idx` (`from_id`,`client_msg_id`),
   KEY `from_id` (`from_id`,`to_id`,`kind`,`seq`),
-  KEY `unread_idx` (`from_id`,`to_id`,`seq`)
+  KEY `unread_idx` (`from_id`,`to_id`,`seq`),
+  KEY `group_seq_idx` (`to_id`,`kind`,`seq`),
+  KEY `client_seq_idx` (`from_id`,`to_id`,`kind`,`client_seq`),
+  KEY `expire_idx` (`expire_at`),
+  FULLTEXT KEY `content_ft` (`content`) /*!50100 WITH PARSER `ngram` */
 ) ENGINE=InnoDB AUTO_INCREMENT=1164 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+--
+-- Table structure for table `message_deletion`
+--
+
+DROP TABLE IF EXISTS `message_deletion`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `message_deletion` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `message_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_message` (`user_id`,`message_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `message_mention`
+--
+
+DROP TABLE IF EXISTS `message_mention`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `message_mention` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `message_id` bigint NOT NULL,
+  `group_id` bigint NOT NULL,
+  `from_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL DEFAULT '0',
+  `seq` bigint NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `message_id` (`message_id`),
+  KEY `group_user_seq` (`group_id`,`user_id`,`seq`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `moderation_log`
+--
+
+DROP TABLE IF EXISTS `moderation_log`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `moderation_log` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `source` varchar(20) NOT NULL,
+  `scene` varchar(20) NOT NULL,
+  `user_id` bigint NOT NULL DEFAULT '0',
+  `filter` varchar(20) NOT NULL,
+  `action` varchar(10) NOT NULL,
+  `reason` varchar(255) NOT NULL DEFAULT '',
+  `content` varchar(2000) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_moderation_log_created` (`created_at`),
+  KEY `idx_moderation_log_user` (`user_id`,`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `one_time_prekey`
+--
+
+DROP TABLE IF EXISTS `one_time_prekey`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `one_time_prekey` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `device_id` varchar(64) NOT NULL,
+  `key_id` bigint NOT NULL,
+  `public_key` varchar(255) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `device_key_idx` (`user_id`,`device_id`,`key_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `outbox`
+--
+
+DROP TABLE IF EXISTS `outbox`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `outbox` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `source` varchar(20) NOT NULL,
+  `topic` varchar(50) NOT NULL,
+  `msg_key` varbinary(255) NOT NULL DEFAULT '',
+  `body` mediumblob NOT NULL,
+  `status` varchar(10) NOT NULL DEFAULT 'pending',
+  `attempts` int NOT NULL DEFAULT '0',
+  `next_at` timestamp NOT NULL,
+  `last_error` varchar(255) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `due_idx` (`source`,`status`,`next_at`),
+  KEY `purge_idx` (`source`,`status`,`updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `push_token`
+--
+
+DROP TABLE IF EXISTS `push_token`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `push_token` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `platform` varchar(10) NOT NULL,
+  `token` varchar(255) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `token_idx` (`token`),
+  KEY `user_idx` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `scheduled_message`
+--
+
+DROP TABLE IF EXISTS `scheduled_message`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `scheduled_message` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `to_id` bigint NOT NULL,
+  `kind` varchar(10) NOT NULL,
+  `type` varchar(20) NOT NULL DEFAULT '',
+  `content` text NOT NULL,
+  `mentions` text NOT NULL,
+  `mention_all` tinyint(1) NOT NULL DEFAULT '0',
+  `send_at` timestamp NOT NULL,
+  `status` varchar(10) NOT NULL DEFAULT 'pending',
+  `message_id` bigint NOT NULL DEFAULT '0',
+  `error` varchar(255) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `due_idx` (`status`,`send_at`),
+  KEY `user_idx` (`user_id`,`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `user_block`
+--
+
+DROP TABLE IF EXISTS `user_block`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `user_block` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `target_id` bigint NOT NULL,
+  `hide_group` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_target_idx` (`user_id`,`target_id`),
+  KEY `target_idx` (`target_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 --
 -- Table structure for table `user_session`
 --
@@ -154,6 +544,11 @@ CREATE TABLE `user_session` (
   `to_id` bigint NOT NULL,
   `kind` varchar(10) NOT NULL,
   `seq` bigint NOT NULL DEFAULT '0',
+  `pinned_at` timestamp NULL DEFAULT NULL,
+  `muted_at` timestamp NULL DEFAULT NULL,
+  `archived_at` timestamp NULL DEFAULT NULL,
+  `hidden_at` timestamp NULL DEFAULT NULL,
+  `cleared_seq` bigint NOT NULL DEFAULT '0',
   `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   `deleted_at` timestamp NULL DEFAULT NULL,
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -177,14 +572,97 @@ CREATE TABLE `users` (
   `gender` varchar(6) DEFAULT NULL,
   `avatar` varchar(150) DEFAULT NULL,
   `password` varchar(100) NOT NULL,
+  `is_bot` tinyint(1) NOT NULL DEFAULT '0',
+  `owner_id` bigint NOT NULL DEFAULT '0',
+  `message_policy` varchar(10) NOT NULL DEFAULT '',
+  `push_preview` varchar(10) NOT NULL DEFAULT '',
+  `quiet_hours` varchar(11) NOT NULL DEFAULT '',
+  `tz_offset` int NOT NULL DEFAULT '0',
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   `deleted_at` datetime(3) DEFAULT NULL,
   PRIMARY KEY (`id`),
   UNIQUE KEY `idx_users_phone` (`phone`),
+  KEY `idx_users_owner` (`owner_id`),
   KEY `idx_users_deleted_at` (`deleted_at`)
 ) ENGINE=InnoDB AUTO_INCREMENT=16 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `webhook_attempt`
+--
+
+DROP TABLE IF EXISTS `webhook_attempt`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `webhook_attempt` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `delivery_id` bigint NOT NULL,
+  `subscription_id` bigint NOT NULL,
+  `event_id` varchar(32) NOT NULL,
+  `event_type` varchar(50) NOT NULL,
+  `attempt` int NOT NULL,
+  `status_code` int NOT NULL DEFAULT '0',
+  `error` varchar(255) NOT NULL DEFAULT '',
+  `duration_ms` bigint NOT NULL DEFAULT '0',
+  `result` varchar(10) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `subscription_idx` (`subscription_id`,`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `webhook_delivery`
+--
+
+DROP TABLE IF EXISTS `webhook_delivery`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `webhook_delivery` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `source` varchar(20) NOT NULL,
+  `subscription_id` bigint NOT NULL,
+  `event_id` varchar(32) NOT NULL,
+  `event_type` varchar(50) NOT NULL,
+  `payload` mediumblob NOT NULL,
+  `status` varchar(10) NOT NULL DEFAULT 'pending',
+  `attempts` int NOT NULL DEFAULT '0',
+  `next_at` timestamp NOT NULL,
+  `last_error` varchar(255) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `due_idx` (`source`,`status`,`next_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `webhook_subscription`
+--
+
+DROP TABLE IF EXISTS `webhook_subscription`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `webhook_subscription` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `owner_id` bigint NOT NULL,
+  `scope_kind` varchar(10) NOT NULL,
+  `scope_id` bigint NOT NULL,
+  `url` varchar(500) NOT NULL,
+  `secret` varchar(64) NOT NULL,
+  `events` varchar(1000) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT NULL,
+  `deleted_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `owner_idx` (`owner_id`),
+  KEY `scope_idx` (`scope_kind`,`scope_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
 /*!40103 SET TIME_ZONE=@OLD_TIME_ZONE */;
 
 /*!40101 SET SQL_MODE=@OLD_SQL_MODE */;
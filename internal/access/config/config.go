@@ -15,6 +15,8 @@ import (
 )
 
 type Config struct {
+	// 接入节点的固定ID，每个节点不同，用作公告消费组名
+	NodeId        string             `yaml:"node_id"`
 	Debug         bool               `yaml:"debug"`
 	Pprof         bool               `yaml:"pprof"`
 	Http          http.Config        `yaml:"http"`
@@ -0,0 +1,44 @@
+package dedup
+
+import (
+	"sync"
+	"time"
+)
+
+// Set 记录最近见过的事件ID，按两代轮换淘汰，ID 至少保留 ttl
+type Set struct {
+	m       sync.Mutex
+	ttl     time.Duration
+	cur     map[string]struct{}
+	prev    map[string]struct{}
+	rotated time.Time
+}
+
+func New(ttl time.Duration) *Set {
+	return &Set{
+		ttl:     ttl,
+		cur:     make(map[string]struct{}),
+		prev:    make(map[string]struct{}),
+		rotated: time.Now(),
+	}
+}
+
+// Add 返回 false 表示 ID 已经出现过
+func (s *Set) Add(id string) bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if time.Since(s.rotated) >= s.ttl {
+		s.prev, s.cur = s.cur, make(map[string]struct{}, len(s.cur))
+		s.rotated = time.Now()
+	}
+	if _, ok := s.cur[id]; ok {
+		return false
+	}
+	if _, ok := s.prev[id]; ok {
+		// 移到当前代，继续保留
+		s.cur[id] = struct{}{}
+		return false
+	}
+	s.cur[id] = struct{}{}
+	return true
+}
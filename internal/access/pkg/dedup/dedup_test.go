@@ -0,0 +1,28 @@
+package dedup
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSet(t *testing.T) {
+	s := New(50 * time.Millisecond)
+	if !s.Add("message-1") {
+		t.Fatal("first add should succeed")
+	}
+	if s.Add("message-1") {
+		t.Fatal("duplicate id should be rejected")
+	}
+	time.Sleep(60 * time.Millisecond)
+	// 轮换一次后仍在上一代中
+	if s.Add("message-1") {
+		t.Fatal("id should survive one rotation")
+	}
+	time.Sleep(60 * time.Millisecond)
+	s.Add("message-2")
+	time.Sleep(60 * time.Millisecond)
+	s.Add("message-2")
+	if !s.Add("message-1") {
+		t.Fatal("id should expire after two rotations")
+	}
+}
@@ -2,6 +2,7 @@ package msglist
 
 import (
 	"go-im/api/access"
+	"slices"
 	"sync"
 )
 
@@ -9,8 +10,10 @@ type node struct {
 	Content *access.Message
 	seq     int64
 	Unread  int
-	next    *node
-	pre     *node
+	// 拉黑并隐藏了发送者的用户，只在服务端使用，不随消息下发
+	hidden []int64
+	next   *node
+	pre    *node
 }
 
 type MsgList struct {
@@ -29,14 +32,17 @@ func NewMsgList() *MsgList {
 	}
 }
 
-func (l *MsgList) List(seq int64) []*access.Message {
+// List 返回 seq 之后的消息，跳过对 userId 隐藏的消息
+func (l *MsgList) List(seq int64, userId int64) []*access.Message {
 	head := l.head.next
 	for head != nil && head.seq < seq {
 		head = head.next
 	}
 	result := make([]*access.Message, 0, 10)
 	for head != nil {
-		result = append(result, head.Content)
+		if !slices.Contains(head.hidden, userId) {
+			result = append(result, head.Content)
+		}
 		head = head.next
 	}
 	return result
@@ -50,6 +56,7 @@ func (l *MsgList) Insert(msg *access.Message, msgBody *access.MessageBody, unrea
 		seq:     msgBody.Seq,
 		Content: msg,
 		Unread:  unread,
+		hidden:  msgBody.HiddenFor,
 	}
 	if l.tail == nil {
 		l.head.next = newNode
@@ -93,3 +100,39 @@ func (l *MsgList) AckMsg(seq int64) {
 		}
 	}
 }
+
+// Remove 删除指定序号的消息，不论是否已读
+func (l *MsgList) Remove(seq int64) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	for node := l.head.next; node != nil; node = node.next {
+		if node.seq != seq {
+			continue
+		}
+		node.pre.next = node.next
+		if node.next != nil {
+			node.next.pre = node.pre
+		}
+		if node == l.tail {
+			l.tail = node.pre
+			if l.tail == l.head {
+				l.tail = nil
+			}
+		}
+		l.size--
+	}
+	delete(l.loc, seq)
+}
+
+// Update 用 f 的返回值替换指定序号的消息
+func (l *MsgList) Update(seq int64, f func(*access.Message) *access.Message) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	node, ok := l.loc[seq]
+	if !ok {
+		return
+	}
+	node.Content = f(node.Content)
+}
@@ -120,6 +120,18 @@ func (c *Conn) dealMessage(msg *access.Message) error {
 			fallthrough
 		case protocol.GroupInfoUpdatedMsg:
 			fallthrough
+		case protocol.MentionMsg:
+			fallthrough
+		case protocol.ReadReceiptMsg:
+			fallthrough
+		case protocol.MessageDeletedMsg:
+			fallthrough
+		case protocol.MessageExpiredMsg:
+			fallthrough
+		case protocol.BroadcastMsg:
+			fallthrough
+		case protocol.CardUpdatedMsg:
+			fallthrough
 		case protocol.NewMessageMsg:
 			if ack.AckId != nil {
 				c.ackQueue.Ack(*ack.AckId)
@@ -127,13 +139,18 @@ func (c *Conn) dealMessage(msg *access.Message) error {
 		case protocol.MessageMsg:
 			if ack.Kind != nil && ack.Id != nil && ack.Seq != nil {
 				c.pollMutext.Lock()
-				if *ack.Seq > c.acked {
-					c.svc.msgbox.Ack(*ack.Kind, *ack.Id, *ack.Seq)
+				boxId := *ack.Id
+				if *ack.Kind == "group" {
+					boxId = ack.GetGroupId()
+				}
+				if *ack.Seq > c.acked && boxId > 0 {
+					c.svc.msgbox.Ack(*ack.Kind, boxId, *ack.Seq)
 					c.acked = *ack.Seq
 				}
 				c.pollMutext.Unlock()
 			}
 			_, err = c.svc.MessageRpc.AckMessage(context.Background(), &message.AckMessageReq{
+				UserId:    c.userId,
 				SessionId: *ack.Id,
 				Seq:       *ack.Seq,
 			})
@@ -153,7 +170,11 @@ func (c *Conn) dealMessage(msg *access.Message) error {
 			c.pollMutext.Unlock()
 			return err
 		}
-		msgs := c.svc.msgbox.List(req.Kind, req.SessionId, req.Seq)
+		boxId := req.SessionId
+		if req.Kind == "group" {
+			boxId = req.GroupId
+		}
+		msgs := c.svc.msgbox.List(req.Kind, boxId, req.Seq, c.userId)
 		b, _ := mjson.Marshal(msgs)
 		resp := &access.Message{
 			Type: int64(protocol.MessageMsg),
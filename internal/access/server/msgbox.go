@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go-im/api/access"
 	"go-im/internal/access/pkg/msglist"
+	"go-im/internal/pkg/mjson"
 	"hash/crc32"
 	"sync"
 )
@@ -35,7 +36,29 @@ func (b *bucket) Ack(key string, seq int64) {
 	list.AckMsg(seq)
 }
 
-func (b *bucket) List(key string, seq int64) []*access.Message {
+func (b *bucket) Remove(key string, seq int64) {
+	b.rwmutex.RLock()
+	list, ok := b.entries[key]
+	if !ok {
+		b.rwmutex.RUnlock()
+		return
+	}
+	b.rwmutex.RUnlock()
+	list.Remove(seq)
+}
+
+func (b *bucket) Update(key string, seq int64, f func(*access.Message) *access.Message) {
+	b.rwmutex.RLock()
+	list, ok := b.entries[key]
+	if !ok {
+		b.rwmutex.RUnlock()
+		return
+	}
+	b.rwmutex.RUnlock()
+	list.Update(seq, f)
+}
+
+func (b *bucket) List(key string, seq int64, userId int64) []*access.Message {
 	b.rwmutex.RLock()
 	list, ok := b.entries[key]
 	if !ok {
@@ -43,7 +66,7 @@ func (b *bucket) List(key string, seq int64) []*access.Message {
 		return nil
 	}
 	b.rwmutex.RUnlock()
-	return list.List(seq)
+	return list.List(seq, userId)
 }
 
 type MsgBox struct {
@@ -58,7 +81,8 @@ func NewMsgBox() *MsgBox {
 	}
 }
 
-func (mb *MsgBox) List(kind string, sessionId, seq int64) []*access.Message {
+// List userId 为拉取消息的用户，对其隐藏的群消息不返回
+func (mb *MsgBox) List(kind string, sessionId, seq int64, userId int64) []*access.Message {
 	k := key(kind, sessionId)
 	index := hash(k)
 	i := index % len(mb.box)
@@ -69,11 +93,11 @@ func (mb *MsgBox) List(kind string, sessionId, seq int64) []*access.Message {
 		return nil
 	}
 	mb.rwm.RUnlock()
-	return btk.List(k, seq)
+	return btk.List(k, seq, userId)
 }
 
 func (mb *MsgBox) Append(msg *access.Message, msgBody *access.MessageBody, unread int) {
-	k := key(msgBody.Kind, msgBody.SessionId)
+	k := key(msgBody.Kind, boxId(msgBody))
 	index := hash(k)
 	i := index % len(mb.box)
 	mb.rwm.RLock()
@@ -108,6 +132,55 @@ func (mb *MsgBox) Ack(kind string, sessionId int64, seq int64) {
 	btk.Ack(k, seq)
 }
 
+func (mb *MsgBox) Remove(kind string, sessionId int64, seq int64) {
+	k := key(kind, sessionId)
+	index := hash(k)
+	i := index % len(mb.box)
+
+	mb.rwm.RLock()
+	btk := mb.box[i]
+	mb.rwm.RUnlock()
+	if btk == nil {
+		return
+	}
+	btk.Remove(k, seq)
+}
+
+// UpdateContent 替换消息盒子中指定消息的内容
+func (mb *MsgBox) UpdateContent(kind string, sessionId int64, seq int64, content string) {
+	k := key(kind, sessionId)
+	index := hash(k)
+	i := index % len(mb.box)
+
+	mb.rwm.RLock()
+	btk := mb.box[i]
+	mb.rwm.RUnlock()
+	if btk == nil {
+		return
+	}
+	btk.Update(k, seq, func(msg *access.Message) *access.Message {
+		var body access.MessageBody
+		if err := mjson.Unmarshal([]byte(msg.Data), &body); err != nil {
+			return msg
+		}
+		body.Content = content
+		b, _ := mjson.Marshal(&body)
+		// 旧消息可能还在 ackQueue 中，复制一份再修改
+		return &access.Message{
+			Type: msg.Type,
+			Data: string(b),
+		}
+	})
+}
+
+// boxId 单聊按接收方的会话区分，群聊各成员的会话ID不同，按群ID区分
+func boxId(msgBody *access.MessageBody) int64 {
+	if msgBody.Kind == "group" {
+		return msgBody.ToId
+	}
+	return msgBody.SessionId
+}
+
 func key(kind string, sessionId int64) string {
 	return fmt.Sprintf("box-%s:%d", kind, sessionId)
 }
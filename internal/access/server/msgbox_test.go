@@ -3,6 +3,7 @@ package server
 import (
 	"go-im/api/access"
 	"go-im/internal/common/protocol"
+	"go-im/internal/pkg/mjson"
 	"testing"
 )
 
@@ -12,20 +13,138 @@ func TestMsgBox(t *testing.T) {
 		b.Append(&access.Message{
 			Type: int64(protocol.MessageMsg),
 			Data: "test",
-		}, nil, 1)
+		}, &access.MessageBody{
+			Kind:      "single",
+			SessionId: 1,
+			Seq:       int64(i + 1),
+		}, 1)
 	}
 
-	list := b.List("", 1, 0)
+	list := b.List("single", 1, 0, 0)
 	for _, item := range list {
 		t.Logf("%+v\n", item)
 	}
 
 	t.Log("----------------------")
 
-	b.Ack("", 1, 10)
+	b.Ack("single", 1, 10)
 
-	list = b.List("", 1, 10)
+	list = b.List("single", 1, 10, 0)
 	for _, item := range list {
 		t.Logf("%+v\n", item)
 	}
+	if len(list) != 10 {
+		t.Fatalf("expect 10 messages after ack, got %d", len(list))
+	}
+
+	b.Remove("single", 1, 15)
+	b.Remove("single", 1, 20)
+	list = b.List("single", 1, 10, 0)
+	if len(list) != 8 {
+		t.Fatalf("expect 8 messages after remove, got %d", len(list))
+	}
+}
+
+func TestMsgBoxGroups(t *testing.T) {
+	b := NewMsgBox()
+	for _, groupId := range []int64{1, 2} {
+		for i := 0; i < 5; i++ {
+			b.Append(&access.Message{
+				Type: int64(protocol.MessageMsg),
+				Data: "test",
+			}, &access.MessageBody{
+				Kind: "group",
+				ToId: groupId,
+				Seq:  int64(i + 1),
+			}, 2)
+		}
+	}
+
+	// 两个群的消息序号相同，删除一个群的消息不影响另一个群
+	b.Remove("group", 1, 3)
+	if list := b.List("group", 1, 0, 0); len(list) != 4 {
+		t.Fatalf("expect 4 messages in group 1, got %d", len(list))
+	}
+	if list := b.List("group", 2, 0, 0); len(list) != 5 {
+		t.Fatalf("expect 5 messages in group 2, got %d", len(list))
+	}
+}
+
+func TestMsgBoxUpdateGroupContent(t *testing.T) {
+	b := NewMsgBox()
+	for _, groupId := range []int64{1, 2} {
+		body := &access.MessageBody{
+			Kind:    "group",
+			ToId:    groupId,
+			Seq:     1,
+			Content: "old",
+		}
+		data, _ := mjson.Marshal(body)
+		b.Append(&access.Message{
+			Type: int64(protocol.MessageMsg),
+			Data: string(data),
+		}, body, 2)
+	}
+
+	b.UpdateContent("group", 1, 1, "new")
+	for groupId, want := range map[int64]string{1: "new", 2: "old"} {
+		list := b.List("group", groupId, 0, 0)
+		if len(list) != 1 {
+			t.Fatalf("expect 1 message in group %d, got %d", groupId, len(list))
+		}
+		var body access.MessageBody
+		if err := mjson.Unmarshal([]byte(list[0].Data), &body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Content != want {
+			t.Fatalf("expect content %q in group %d, got %q", want, groupId, body.Content)
+		}
+	}
+}
+
+func TestMsgBoxHidden(t *testing.T) {
+	b := NewMsgBox()
+	for i := 0; i < 2; i++ {
+		body := &access.MessageBody{
+			Kind: "group",
+			ToId: 1,
+			Seq:  int64(i + 1),
+		}
+		if i == 0 {
+			body.HiddenFor = []int64{3}
+		}
+		b.Append(&access.Message{
+			Type: int64(protocol.MessageMsg),
+			Data: "test",
+		}, body, 3)
+	}
+	if list := b.List("group", 1, 0, 2); len(list) != 2 {
+		t.Fatalf("expect 2 messages for user 2, got %d", len(list))
+	}
+	if list := b.List("group", 1, 0, 3); len(list) != 1 {
+		t.Fatalf("expect 1 message for user 3, got %d", len(list))
+	}
+}
+
+func TestMsgBoxGroupAck(t *testing.T) {
+	b := NewMsgBox()
+	b.Append(&access.Message{
+		Type: int64(protocol.MessageMsg),
+		Data: "test",
+	}, &access.MessageBody{
+		Kind: "group",
+		ToId: 1,
+		Seq:  1,
+	}, 2)
+	if list := b.List("group", 1, 0, 0); len(list) != 1 {
+		t.Fatalf("expect 1 message, got %d", len(list))
+	}
+	b.Ack("group", 1, 1)
+	if list := b.List("group", 1, 0, 0); len(list) != 1 {
+		t.Fatalf("expect message kept until all members ack, got %d", len(list))
+	}
+	b.Ack("group", 1, 1)
+	if list := b.List("group", 1, 0, 0); len(list) != 0 {
+		t.Fatalf("expect message freed after all members ack, got %d", len(list))
+	}
 }
@@ -6,6 +6,7 @@ import (
 	"go-im/api/message"
 	"go-im/api/user"
 	"go-im/internal/access/config"
+	"go-im/internal/access/pkg/dedup"
 	"go-im/internal/common/middleware/mgrpc"
 	"go-im/internal/common/protocol"
 	"go-im/internal/pkg/kafka"
@@ -13,16 +14,22 @@ import (
 	"go-im/internal/pkg/mjson"
 	"go-im/internal/pkg/utils"
 	"strconv"
+	"time"
 
 	"net/http"
+	"slices"
 	"sync"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
+	kafkago "github.com/segmentio/kafka-go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// 覆盖 outbox 的最长重试间隔
+const eventDedupTTL = 10 * time.Minute
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -41,6 +48,8 @@ type WsServer struct {
 	conns  map[int64]*Conn
 	msgCh  chan *protocol.PushBody
 	msgbox *MsgBox
+	// 上游 outbox 至少投递一次，按事件ID去重
+	seen *dedup.Set
 
 	m sync.Mutex
 
@@ -83,6 +92,7 @@ func NewServer(c *config.Config) *WsServer {
 		m:          sync.Mutex{},
 		msgCh:      make(chan *protocol.PushBody, 1000),
 		msgbox:     NewMsgBox(),
+		seen:       dedup.New(eventDedupTTL),
 	}
 	if c.Kafka.Enable {
 		go ws.consume()
@@ -123,6 +133,7 @@ func (ws *WsServer) Handler(ctx *gin.Context) {
 
 func (ws *WsServer) PushMessage(ctx context.Context, in *access.PushMessageReq) (*access.PushMessageResp, error) {
 	ws.Send(&protocol.PushBody{
+		Id:   in.Id,
 		Type: in.Type,
 		Key:  in.Key,
 		Body: in.Body,
@@ -136,6 +147,9 @@ func (ws *WsServer) handleMsg() {
 		case <-ws.ctx.Done():
 			return
 		case pushBody := <-ws.msgCh:
+			if pushBody.Id != "" && !ws.seen.Add(pushBody.Id) {
+				continue
+			}
 			var msg access.Message
 			switch pushBody.Type {
 			case protocol.MessageTopic:
@@ -157,20 +171,36 @@ func (ws *WsServer) handleMsg() {
 						continue
 					}
 					content := &access.NewMessageNotifyMsg{
-						Kind: msgBody.Kind,
-						Seq:  msgBody.Seq,
+						Kind:    msgBody.Kind,
+						Seq:     msgBody.Seq,
+						GroupId: msgBody.ToId,
 					}
+					// 隐藏名单只留在消息盒子里，下发给客户端的内容中去掉
+					hidden := msgBody.HiddenFor
+					msgBody.HiddenFor = nil
+					b, _ := mjson.Marshal(&msgBody)
 					msg = access.Message{
 						Type: int64(protocol.MessageMsg),
-						Data: string(pushBody.Body),
+						Data: string(b),
 					}
+					msgBody.HiddenFor = hidden
+					// 群消息在盒子里只存一份，由除发送者外的成员共同确认
+					unread := 0
+					for _, member := range resp.Members {
+						if member.Id != msgBody.FromId {
+							unread++
+						}
+					}
+					ws.msgbox.Append(&msg, &msgBody, unread)
 					ws.m.Lock()
 					for _, member := range resp.Members {
 						if member.Id == msgBody.FromId {
 							continue
 						}
 						content.SessionId = member.SessionId
-						ws.msgbox.Append(&msg, &msgBody, len(resp.Members))
+						if slices.Contains(msgBody.HiddenFor, member.Id) {
+							continue
+						}
 						c, ok := ws.conns[member.Id]
 						if ok {
 							b, _ := mjson.Marshal(content)
@@ -323,6 +353,64 @@ func (ws *WsServer) handleMsg() {
 						c.Send(&msg)
 					}
 				}
+			case protocol.MessageEventTopic:
+				contentType, _ := strconv.Atoi(string(pushBody.Key))
+				switch contentType {
+				case protocol.MentionMsg:
+					body := access.MentionNotifyMsg{}
+					err := mjson.Unmarshal(pushBody.Body, &body)
+					if err != nil {
+						log.Errorf("unmarshal mention notify msg failed, %v", err)
+						continue
+					}
+					ws.sendTo(body.ToId, contentType, pushBody.Body)
+				case protocol.ReadReceiptMsg:
+					body := access.ReadReceiptMsg{}
+					err := mjson.Unmarshal(pushBody.Body, &body)
+					if err != nil {
+						log.Errorf("unmarshal read receipt msg failed, %v", err)
+						continue
+					}
+					ws.sendTo(body.ToId, contentType, pushBody.Body)
+				case protocol.MessageDeletedMsg:
+					body := access.MessageDeletedMsg{}
+					err := mjson.Unmarshal(pushBody.Body, &body)
+					if err != nil {
+						log.Errorf("unmarshal message deleted msg failed, %v", err)
+						continue
+					}
+					if body.ForEveryone || body.SessionId > 0 {
+						ws.msgbox.Remove(body.Kind, body.SessionId, body.Seq)
+					}
+					ws.sendTo(body.ToId, contentType, pushBody.Body)
+				case protocol.MessageExpiredMsg:
+					body := access.MessageExpiredMsg{}
+					err := mjson.Unmarshal(pushBody.Body, &body)
+					if err != nil {
+						log.Errorf("unmarshal message expired msg failed, %v", err)
+						continue
+					}
+					ws.msgbox.Remove(body.Kind, body.SessionId, body.Seq)
+					ws.sendTo(body.ToId, contentType, pushBody.Body)
+				case protocol.CardUpdatedMsg:
+					body := access.CardUpdatedMsg{}
+					err := mjson.Unmarshal(pushBody.Body, &body)
+					if err != nil {
+						log.Errorf("unmarshal card updated msg failed, %v", err)
+						continue
+					}
+					// 消息盒子中未拉取的副本也换成新内容
+					ws.msgbox.UpdateContent(body.Kind, body.SessionId, body.Seq, body.Content)
+					ws.sendTo(body.ToId, contentType, pushBody.Body)
+				}
+			case protocol.BroadcastTopic:
+				body := access.BroadcastMsg{}
+				err := mjson.Unmarshal(pushBody.Body, &body)
+				if err != nil {
+					log.Errorf("unmarshal broadcast msg failed, %v", err)
+					continue
+				}
+				ws.sendTo(body.ToId, protocol.BroadcastMsg, pushBody.Body)
 			default:
 				continue
 			}
@@ -330,58 +418,76 @@ func (ws *WsServer) handleMsg() {
 	}
 }
 
+// 每个连接单独一份消息，ackQueue 会回写 AckId
+func (ws *WsServer) sendTo(toId []int64, contentType int, data []byte) {
+	to := make([]*Conn, 0, len(toId))
+	ws.m.Lock()
+	for _, v := range toId {
+		c, ok := ws.conns[v]
+		if ok {
+			to = append(to, c)
+		}
+	}
+	ws.m.Unlock()
+	for _, c := range to {
+		msg := &access.Message{
+			Type: int64(contentType),
+			Data: string(data),
+		}
+		c.ackQueue.Put(msg)
+		c.Send(msg)
+	}
+}
+
 func (ws *WsServer) consume() {
 	if len(ws.c.Kafka.Brokers) == 0 {
 		return
 	}
-	if len(ws.c.Kafka.ConsumerGroup) > 0 {
-		for _, group := range ws.c.Kafka.ConsumerGroup {
-			r := kafka.NewGroupReader(ws.c.Kafka, group.Group, group.Topic)
-			utils.SafeGo(func() {
-				defer r.Close()
-				for {
-					select {
-					case <-ws.ctx.Done():
-						return
-					default:
-					}
-					m, err := r.ReadMessage(context.Background())
-					if err != nil {
-						log.Errorf("fetch message failed, %v", err)
-						continue
-					}
-					body := &protocol.PushBody{
-						Type: m.Topic,
-						Body: m.Value,
-					}
-					ws.Send(body)
-				}
-			})
-		}
+	for _, group := range ws.c.Kafka.ConsumerGroup {
+		ws.consumeReader(kafka.NewGroupReader(ws.c.Kafka, group.Group, group.Topic))
 	}
 	if ws.c.Kafka.Topic != "" {
-		r := kafka.NewReader(ws.c.Kafka)
-		utils.SafeGo(func() {
-			defer r.Close()
-			for {
-				select {
-				case <-ws.ctx.Done():
-					return
-				default:
-				}
-				m, err := r.ReadMessage(context.Background())
-				if err != nil {
-					log.Errorf("fetch message failed, %v", err)
-					continue
-				}
-				body := &protocol.PushBody{
-					Type: m.Topic,
-					Body: m.Value,
-				}
-				ws.Send(body)
+		ws.consumeReader(kafka.NewReader(ws.c.Kafka))
+	}
+	// 公告要发到每个接入节点，每个节点用配置里固定的节点ID单独一个消费组，重启和扩容不会留下废弃的消费组
+	if ws.c.NodeId == "" {
+		log.Warn("node_id is empty, broadcast will not be pushed by this node")
+		return
+	}
+	ws.consumeReader(kafka.NewLatestGroupReader(ws.c.Kafka, "access-broadcast-"+ws.c.NodeId, protocol.BroadcastTopic))
+}
+
+func (ws *WsServer) consumeReader(r *kafkago.Reader) {
+	utils.SafeGo(func() {
+		defer r.Close()
+		for {
+			select {
+			case <-ws.ctx.Done():
+				return
+			default:
+			}
+			m, err := r.ReadMessage(context.Background())
+			if err != nil {
+				log.Errorf("fetch message failed, %v", err)
+				continue
 			}
-		})
+			ws.Send(&protocol.PushBody{
+				Id:   eventId(m),
+				Type: m.Topic,
+				Key:  m.Key,
+				Body: m.Value,
+			})
+		}
+	})
+}
+
+func eventId(m kafkago.Message) string {
+	for _, h := range m.Headers {
+		if h.Key == protocol.EventIdHeader {
+			return string(h.Value)
+		}
 	}
+	return ""
 }
 
 func (ws *WsServer) Stop() {
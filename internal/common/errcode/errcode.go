@@ -11,15 +11,20 @@ import (
 var (
 	ErrInvalidParam        = NewError(400, "参数错误")
 	ErrUnAuthorized        = NewError(401, "未授权")
+	ErrForbidden           = NewError(403, "无权操作")
+	ErrTooManyRequests     = NewError(429, "请求过于频繁")
 	ErrServerInternalError = NewError(500, "服务器异常")
 )
 
 // user
 var (
-	ErrUserNotExists = NewError(10001, "用户不存在")
-	ErrPasswordWrong = NewError(10002, "密码错误")
-	ErrPhoneRegisted = NewError(10003, "手机号已注册")
-	ErrTokenExpired  = NewError(10004, "token已失效")
+	ErrUserNotExists   = NewError(10001, "用户不存在")
+	ErrPasswordWrong   = NewError(10002, "密码错误")
+	ErrPhoneRegisted   = NewError(10003, "手机号已注册")
+	ErrTokenExpired    = NewError(10004, "token已失效")
+	ErrBotNotExists    = NewError(10005, "机器人不存在")
+	ErrBlocked         = NewError(10006, "已被对方拉黑")
+	ErrDeviceNotExists = NewError(10007, "设备不存在")
 )
 
 // friends
@@ -37,11 +42,32 @@ var (
 	ErrSessionNotExists = NewError(30002, "会话不存在")
 	ErrCreateMessage    = NewError(30003, "创建消息失败")
 	ErrNotFriend        = NewError(30004, "非好友关系")
+	ErrStrangerLimit    = NewError(30005, "对方回复前无法继续发送消息")
 )
 
 // message
 var (
-	ErrMessageExists = NewError(40001, "消息重复")
+	ErrMessageExists    = NewError(40001, "消息重复")
+	ErrMentionNotMember = NewError(40002, "被@用户非群组成员")
+	ErrMentionAllDenied = NewError(40003, "仅群主或管理员可@所有人")
+	ErrMessageNotExists = NewError(40004, "消息不存在")
+	ErrRevokeDenied     = NewError(40005, "无权对所有人删除该消息")
+	ErrRevokeExpired    = NewError(40006, "已超过可删除时间")
+
+	ErrScheduleTimeInvalid = NewError(40007, "定时发送时间无效")
+	ErrScheduleNotExists   = NewError(40008, "定时消息不存在")
+	ErrScheduleNotPending  = NewError(40009, "定时消息已发送或已取消")
+
+	ErrForwardDenied = NewError(40010, "该消息不支持转发")
+
+	ErrBroadcastNotExists = NewError(40011, "公告不存在")
+
+	ErrExportNotExists = NewError(40012, "导出任务不存在")
+	ErrExportNotReady  = NewError(40013, "导出文件尚未生成")
+
+	ErrEncryptionRequired = NewError(40014, "会话已开启端到端加密")
+	ErrEncryptionDenied   = NewError(40015, "仅单聊支持端到端加密")
+	ErrDeviceMismatch     = NewError(40016, "设备列表已变化，请重新获取密钥")
 )
 
 // group
@@ -58,6 +84,19 @@ var (
 	ErrAvatarExtNotSupported = NewError(60001, "头像文件格式不支持")
 )
 
+// integration
+var (
+	ErrWebhookNotExists  = NewError(70001, "webhook 不存在")
+	ErrWebhookUrlInvalid = NewError(70002, "webhook 地址无效")
+	ErrBotNoResponse     = NewError(70003, "机器人未响应")
+)
+
+// moderation
+var (
+	ErrContentBlocked    = NewError(80001, "内容包含违规信息")
+	ErrContentUrlBlocked = NewError(80002, "内容包含违规链接")
+)
+
 var (
 	codeMap = make(map[int]*Error)
 )
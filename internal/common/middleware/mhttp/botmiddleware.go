@@ -0,0 +1,32 @@
+package mhttp
+
+import (
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/response"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BotAuthMiddleware 校验 "Authorization: Bot <token>"，通过后和 AuthMiddleware 一样设置 user_id
+func BotAuthMiddleware(userRpc user.UserClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bot ")
+		if !ok || token == "" {
+			c.Abort()
+			response.Error(c, errcode.ErrUnAuthorized)
+			return
+		}
+		resp, err := userRpc.BotAuth(c.Request.Context(), &user.BotAuthReq{
+			Token: token,
+		})
+		if err != nil {
+			c.Abort()
+			response.Error(c, errcode.FromRpcError(err))
+			return
+		}
+		c.Set("user_id", resp.BotId)
+		c.Next()
+	}
+}
@@ -1,9 +1,12 @@
 package protocol
 
 const (
-	MessageTopic     = "message"
-	GroupEventTopic  = "group-event"
-	FriendEventTopic = "friend-event"
+	MessageTopic      = "message"
+	MessageEventTopic = "message-event"
+	GroupEventTopic   = "group-event"
+	FriendEventTopic  = "friend-event"
+	// 每个接入节点都要消费，不能共用消费组
+	BroadcastTopic = "broadcast"
 )
 
 const (
@@ -22,9 +25,21 @@ const (
 	GroupInfoUpdatedMsg  int = 10
 	GroupDismissMsg      int = 11
 	GroupMemberChangeMsg int = 12
+
+	MentionMsg        int = 13
+	ReadReceiptMsg    int = 14
+	MessageDeletedMsg int = 15
+	MessageExpiredMsg int = 16
+	BroadcastMsg      int = 17
+	CardUpdatedMsg    int = 18
 )
 
+// Kafka 消息头中的事件ID，消费端据此去重
+const EventIdHeader = "event-id"
+
 type PushBody struct {
+	// outbox 事件ID，为空时不去重
+	Id   string
 	Type string
 	Key  []byte
 	Body []byte
@@ -2,4 +2,36 @@ package types
 
 var (
 	CacheOnlineKey = "online:%d"
+	// 用户资料缓存，修改资料时删除
+	CacheUserProfileKey = "user:profile:%d"
+	// 机器人 token 哈希到机器人ID，吊销时删除
+	CacheBotTokenKey = "bot:token:%s"
+	// 机器人每分钟的请求计数
+	CacheBotRateKey = "bot:rate:%d:%d"
+	// 好友关系缓存，值为 1 或 0，好友变更时删除
+	CacheFriendKey = "friend:%d:%d"
+	// 陌生人在对方回复前已发送的消息数
+	CacheStrangerCountKey = "stranger:count:%d:%d"
+	// 对方已回复过陌生人消息的标记
+	CacheStrangerRepliedKey = "stranger:replied:%d:%d"
+	// 用户的拉黑列表，hash 字段为对方ID，值表示是否隐藏群消息
+	CacheBlockKey = "block:%d"
+	// 拉黑了该用户并隐藏其群消息的用户集合
+	CacheHiderKey = "block:hider:%d"
+	// 用户未吊销的加密设备ID集合
+	CacheDeviceKey = "e2ee:devices:%d"
+	// 离线推送合并窗口，按接收者和会话区分，存在期间的消息只计数
+	CachePushWindowKey = "push:window:%d:%s:%d"
+	// 合并窗口内未推送的消息，hash 字段 count 和 body
+	CachePushPendingKey = "push:pending:%d:%s:%d"
+	// 用户各会话的未读计数，hash 字段为 kind:对方ID 和 mention:群ID
+	CacheUnreadKey = "unread:%d"
+)
+
+// 单聊消息接收策略，用户未设置时使用服务端配置
+const (
+	MessagePolicyFriends = "friends"
+	// 陌生人在对方回复前只能发送有限条数
+	MessagePolicyLimited = "limited"
+	MessagePolicyOpen    = "open"
 )
@@ -22,6 +22,8 @@ type Config struct {
 	UserClient    rpc.ClientConfig   `yaml:"user_client"`
 	MessageClient rpc.ClientConfig   `yaml:"message_client"`
 	Prometheus    mprometheus.Config `jyaml:"prometheus"`
+	// 可以调用管理接口的用户
+	AdminIds []int64 `yaml:"admin_ids"`
 }
 
 func ParseConfig(file string) *Config {
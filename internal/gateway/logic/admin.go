@@ -0,0 +1,141 @@
+package logic
+
+import (
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/middleware/mhttp"
+	"go-im/internal/common/response"
+	"go-im/internal/gateway/server"
+	"go-im/internal/gateway/types"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminApi struct {
+	s *server.Server
+}
+
+func NewAdminApi(s *server.Server) *AdminApi {
+	return &AdminApi{s}
+}
+
+func (api *AdminApi) RegisterRouter(engine *gin.RouterGroup) {
+	admin := engine.Group("/admin", mhttp.AuthMiddleware(), api.adminOnly)
+	{
+		admin.POST("/broadcast", api.CreateBroadcast)
+		admin.GET("/broadcast", api.GetBroadcastStats)
+		admin.POST("/export", api.CreateExport)
+	}
+}
+
+// adminOnly 只允许配置中的管理员访问
+func (api *AdminApi) adminOnly(c *gin.Context) {
+	if !slices.Contains(api.s.AdminIds, c.GetInt64("user_id")) {
+		c.Abort()
+		response.Error(c, errcode.ErrForbidden)
+		return
+	}
+	c.Next()
+}
+
+func (api *AdminApi) CreateBroadcast(c *gin.Context) {
+	var (
+		req  types.CreateBroadcastReq
+		resp types.CreateBroadcastResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.CreateBroadcast(c.Request.Context(), &message.CreateBroadcastReq{
+		OperatorId:      c.GetInt64("user_id"),
+		Title:           req.Title,
+		Content:         req.Content,
+		Segment:         req.Segment,
+		RegisteredAfter: req.RegisteredAfter,
+		GroupIds:        req.GroupIds,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.Id = rpcResp.Id
+}
+
+func (api *AdminApi) GetBroadcastStats(c *gin.Context) {
+	var (
+		req  types.GetBroadcastStatsReq
+		resp types.GetBroadcastStatsResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.GetBroadcastStats(c.Request.Context(), &message.GetBroadcastStatsReq{
+		Id: req.Id,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.GetBroadcastStatsResp{
+		Id:             rpcResp.Id,
+		Title:          rpcResp.Title,
+		Segment:        rpcResp.Segment,
+		Status:         rpcResp.Status,
+		TotalCount:     rpcResp.TotalCount,
+		DeliveredCount: rpcResp.DeliveredCount,
+		ReadCount:      rpcResp.ReadCount,
+		CreateTime:     rpcResp.CreateTime,
+	}
+}
+
+// CreateExport 合规导出任意用户的会话，通过 /message/export 查询进度和下载
+func (api *AdminApi) CreateExport(c *gin.Context) {
+	var (
+		req  types.CreateExportReq
+		resp types.CreateExportResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.CreateExport(c.Request.Context(), &message.CreateExportReq{
+		UserId:     c.GetInt64("user_id"),
+		SessionId:  req.SessionId,
+		Format:     req.Format,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Compliance: true,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.Id = rpcResp.Id
+}
@@ -0,0 +1,262 @@
+package logic
+
+import (
+	"go-im/api/message"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/middleware/mhttp"
+	"go-im/internal/common/response"
+	"go-im/internal/gateway/server"
+	"go-im/internal/gateway/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BotApi struct {
+	s *server.Server
+}
+
+func NewBotApi(s *server.Server) *BotApi {
+	return &BotApi{s}
+}
+
+func (api *BotApi) RegisterRouter(engine *gin.RouterGroup) {
+	// 机器人创建者管理机器人和 token
+	bot := engine.Group("/bot", mhttp.AuthMiddleware())
+	{
+		bot.POST("", api.CreateBot)
+		bot.GET("", api.ListBot)
+		bot.POST("/token", api.CreateBotToken)
+		bot.GET("/token", api.ListBotToken)
+		bot.DELETE("/token", api.RevokeBotToken)
+	}
+	// 机器人使用 API token 调用
+	botApi := engine.Group("/bot/api", mhttp.BotAuthMiddleware(api.s.UserRpc))
+	{
+		botApi.POST("/message", api.SendMessage)
+		botApi.PUT("/card", api.UpdateCard)
+		botApi.GET("/group", NewGroupApi(api.s).ListGroup)
+	}
+}
+
+func (api *BotApi) CreateBot(c *gin.Context) {
+	var (
+		req  types.CreateBotReq
+		resp types.CreateBotResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.UserRpc.CreateBot(c.Request.Context(), &user.CreateBotReq{
+		OwnerId:  c.GetInt64("user_id"),
+		Username: req.Username,
+		Avatar:   req.Avatar,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.BotId = rpcResp.BotId
+	resp.Token = rpcResp.Token
+}
+
+func (api *BotApi) ListBot(c *gin.Context) {
+	var (
+		resp types.ListBotResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	rpcResp, err := api.s.UserRpc.ListBot(c.Request.Context(), &user.ListBotReq{
+		OwnerId: c.GetInt64("user_id"),
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.BotInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.BotInfo{
+			BotId:      item.BotId,
+			Username:   item.Username,
+			Avatar:     item.Avatar,
+			CreateTime: item.CreateTime,
+		})
+	}
+}
+
+func (api *BotApi) CreateBotToken(c *gin.Context) {
+	var (
+		req  types.CreateBotTokenReq
+		resp types.CreateBotTokenResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.UserRpc.CreateBotToken(c.Request.Context(), &user.CreateBotTokenReq{
+		OwnerId: c.GetInt64("user_id"),
+		BotId:   req.BotId,
+		Name:    req.Name,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.Id = rpcResp.Id
+	resp.Token = rpcResp.Token
+}
+
+func (api *BotApi) ListBotToken(c *gin.Context) {
+	var (
+		req  types.ListBotTokenReq
+		resp types.ListBotTokenResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.UserRpc.ListBotToken(c.Request.Context(), &user.ListBotTokenReq{
+		OwnerId: c.GetInt64("user_id"),
+		BotId:   req.BotId,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.BotTokenInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.BotTokenInfo{
+			Id:         item.Id,
+			Name:       item.Name,
+			LastUsedAt: item.LastUsedAt,
+			Revoked:    item.Revoked,
+			CreateTime: item.CreateTime,
+		})
+	}
+}
+
+func (api *BotApi) RevokeBotToken(c *gin.Context) {
+	var (
+		req types.RevokeBotTokenReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.UserRpc.RevokeBotToken(c.Request.Context(), &user.RevokeBotTokenReq{
+		OwnerId: c.GetInt64("user_id"),
+		BotId:   req.BotId,
+		Id:      req.Id,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+// SendMessage 机器人只能在已加入的群中发言
+func (api *BotApi) SendMessage(c *gin.Context) {
+	var (
+		req  types.BotSendMessageReq
+		resp types.SendMessageResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	out, err := api.s.MessageRpc.SendMessage(c.Request.Context(), &message.SendMessageReq{
+		UserId:      c.GetInt64("user_id"),
+		Kind:        "group",
+		ToId:        req.GroupId,
+		Message:     req.Message,
+		Mentions:    req.Mentions,
+		ClientMsgId: req.ClientMsgId,
+		Type:        req.Type,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.SendMessageResp{
+		Id:       out.Id,
+		Seq:      out.Seq,
+		SendTime: out.SendTime,
+	}
+}
+
+func (api *BotApi) UpdateCard(c *gin.Context) {
+	var (
+		req  types.BotUpdateCardReq
+		resp types.BotUpdateCardResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	out, err := api.s.MessageRpc.UpdateCard(c.Request.Context(), &message.UpdateCardReq{
+		BotId:     c.GetInt64("user_id"),
+		MessageId: req.MessageId,
+		Content:   req.Content,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.BotUpdateCardResp{
+		Version: out.Version,
+	}
+}
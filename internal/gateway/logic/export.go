@@ -0,0 +1,130 @@
+package logic
+
+import (
+	"fmt"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/response"
+	"go-im/internal/gateway/types"
+	"go-im/internal/message/pkg/export"
+	"go-im/internal/pkg/log"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (api *MessageApi) CreateExport(c *gin.Context) {
+	var (
+		req  types.CreateExportReq
+		resp types.CreateExportResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.CreateExport(c.Request.Context(), &message.CreateExportReq{
+		UserId:    c.GetInt64("user_id"),
+		SessionId: req.SessionId,
+		Format:    req.Format,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.Id = rpcResp.Id
+}
+
+func (api *MessageApi) GetExport(c *gin.Context) {
+	var (
+		req  types.GetExportReq
+		resp types.GetExportResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.GetExport(c.Request.Context(), &message.GetExportReq{
+		UserId: c.GetInt64("user_id"),
+		Id:     req.Id,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.GetExportResp{
+		Id:           rpcResp.Id,
+		SessionId:    rpcResp.SessionId,
+		Format:       rpcResp.Format,
+		Status:       rpcResp.Status,
+		MessageCount: rpcResp.MessageCount,
+		FileSize:     rpcResp.FileSize,
+		Error:        rpcResp.Error,
+		CreateTime:   rpcResp.CreateTime,
+	}
+	if rpcResp.Status == "done" {
+		resp.DownloadUrl = fmt.Sprintf("/api/message/export/download?id=%d", rpcResp.Id)
+	}
+}
+
+// DownloadExport 把消息服务返回的文件块直接写给客户端，开始写入前的错误仍按 JSON 返回
+func (api *MessageApi) DownloadExport(c *gin.Context) {
+	var (
+		req types.GetExportReq
+		err error
+	)
+	if err = c.BindQuery(&req); err != nil {
+		response.Error(c, errcode.ErrInvalidParam)
+		return
+	}
+	userId := c.GetInt64("user_id")
+	info, err := api.s.MessageRpc.GetExport(c.Request.Context(), &message.GetExportReq{UserId: userId, Id: req.Id})
+	if err != nil {
+		response.Error(c, errcode.FromRpcError(err))
+		return
+	}
+	stream, err := api.s.MessageRpc.DownloadExport(c.Request.Context(), &message.DownloadExportReq{UserId: userId, Id: req.Id})
+	if err != nil {
+		response.Error(c, errcode.FromRpcError(err))
+		return
+	}
+	chunk, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		response.Error(c, errcode.FromRpcError(err))
+		return
+	}
+	c.Header("Content-Type", export.ContentType(info.Format))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="export-%d%s"`, info.Id, export.Ext(info.Format)))
+	if info.FileSize > 0 {
+		c.Header("Content-Length", fmt.Sprint(info.FileSize))
+	}
+	c.Status(http.StatusOK)
+	for err == nil {
+		if _, err = c.Writer.Write(chunk.Data); err != nil {
+			return
+		}
+		chunk, err = stream.Recv()
+	}
+	if err != io.EOF {
+		// 已经开始写入无法再返回错误，响应声明了长度，客户端能发现内容不完整
+		log.Errorf("download export %d failed, err: %v", info.Id, err)
+	}
+}
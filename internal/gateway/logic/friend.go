@@ -156,6 +156,7 @@ func (api *FriendApi) ListFriend(c *gin.Context) {
 			Avatar:   friend.Avatar,
 			Gender:   friend.Gender,
 			Remark:   friend.Remark,
+			IsBot:    friend.IsBot,
 		})
 	}
 	resp = types.ListFriendsResp{
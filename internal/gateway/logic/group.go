@@ -261,6 +261,7 @@ func (api *GroupApi) ListGroup(c *gin.Context) {
 				Id:     mem.Id,
 				Name:   mem.Name,
 				Avatar: mem.Avatar,
+				IsBot:  mem.IsBot,
 			})
 		}
 		infos = append(infos, types.GroupInfo{
@@ -308,6 +309,7 @@ func (api *GroupApi) ListGroupMember(c *gin.Context) {
 			Id:     member.Id,
 			Name:   member.Name,
 			Avatar: member.Avatar,
+			IsBot:  member.IsBot,
 		})
 	}
 	resp = types.ListGroupMemberResp{
@@ -24,9 +24,32 @@ func (api *MessageApi) RegisterRouter(engine *gin.RouterGroup) {
 	{
 		msg.POST("", api.SendMessage)
 		msg.PUT("", api.AckMessage)
+		msg.DELETE("", api.DeleteMessage)
 		msg.GET("/session", api.ListSession)
 		msg.POST("/session", api.CreateSession)
+		msg.DELETE("/session", api.DeleteSession)
+		msg.PUT("/session/pin", api.PinSession)
+		msg.PUT("/session/mute", api.MuteSession)
+		msg.PUT("/session/archive", api.ArchiveSession)
+		msg.PUT("/session/ttl", api.SetSessionTtl)
+		msg.PUT("/session/encryption", api.SetSessionEncryption)
 		msg.GET("/unread", api.UnreadMessage)
+		msg.GET("/unread/summary", api.GetUnreadSummary)
+		msg.GET("/readers", api.ListMessageReader)
+		msg.GET("/history", api.ListHistory)
+		msg.GET("/search", api.SearchMessage)
+		msg.POST("/schedule", api.ScheduleMessage)
+		msg.GET("/schedule", api.ListScheduledMessage)
+		msg.PUT("/schedule", api.RescheduleMessage)
+		msg.DELETE("/schedule", api.CancelScheduledMessage)
+		msg.POST("/forward", api.ForwardMessage)
+		msg.GET("/forward", api.GetMergedForward)
+		msg.GET("/broadcast", api.ListBroadcast)
+		msg.PUT("/broadcast", api.ReadBroadcast)
+		msg.POST("/card/callback", api.CardCallback)
+		msg.POST("/export", api.CreateExport)
+		msg.GET("/export", api.GetExport)
+		msg.GET("/export/download", api.DownloadExport)
 	}
 }
 
@@ -56,6 +79,42 @@ func (api *MessageApi) AckMessage(c *gin.Context) {
 	}
 }
 
+func (api *MessageApi) GetUnreadSummary(c *gin.Context) {
+	var (
+		resp types.UnreadSummaryResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	rpcResp, err := api.s.MessageRpc.GetUnreadSummary(c.Request.Context(), &message.GetUnreadSummaryReq{
+		UserId: c.GetInt64("user_id"),
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.UnreadSummaryResp{
+		Total:    rpcResp.Total,
+		Mentions: rpcResp.Mentions,
+		List:     make([]types.SessionUnread, 0, len(rpcResp.List)),
+	}
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.SessionUnread{
+			SessionId: item.SessionId,
+			Kind:      item.Kind,
+			ToId:      item.ToId,
+			Unread:    item.Unread,
+			Mentions:  item.Mentions,
+			Muted:     item.Muted,
+		})
+	}
+}
+
 func (api *MessageApi) CreateSession(c *gin.Context) {
 	var (
 		req  types.CreateSessionReq
@@ -108,9 +167,20 @@ func (api *MessageApi) ListSession(c *gin.Context) {
 	infos := make([]types.SessionInfo, 0, len(rpcResp.List))
 	for _, item := range rpcResp.List {
 		si := types.SessionInfo{
-			SessionId: item.SessionId,
-			Kind:      item.Kind,
-			Seq:       item.Seq,
+			SessionId:      item.SessionId,
+			Kind:           item.Kind,
+			Seq:            item.Seq,
+			UnreadMentions: item.UnreadMentions,
+			UnreadCount:    item.UnreadCount,
+			Pinned:         item.Pinned,
+			PinnedAt:       item.PinnedAt,
+			Muted:          item.Muted,
+			MutedAt:        item.MutedAt,
+			Archived:       item.Archived,
+			ArchivedAt:     item.ArchivedAt,
+			Ttl:            item.Ttl,
+			TtlMode:        item.TtlMode,
+			Encrypted:      item.Encrypted,
 		}
 		if item.GroupId != nil {
 			si.GroupId = *item.GroupId
@@ -133,6 +203,18 @@ func (api *MessageApi) ListSession(c *gin.Context) {
 		if item.MemberCount != nil {
 			si.MemberCount = *item.MemberCount
 		}
+		if item.LastMessage != nil {
+			si.LastMessage = &types.LastMessage{
+				Id:       item.LastMessage.Id,
+				Seq:      item.LastMessage.Seq,
+				FromId:   item.LastMessage.FromId,
+				FromName: item.LastMessage.FromName,
+				Type:     item.LastMessage.Type,
+				Excerpt:  item.LastMessage.Excerpt,
+				SendTime: item.LastMessage.SendTime,
+				Revoked:  item.LastMessage.Revoked,
+			}
+		}
 		infos = append(infos, si)
 	}
 	resp = types.ListSessionResp{List: infos}
@@ -140,7 +222,46 @@ func (api *MessageApi) ListSession(c *gin.Context) {
 
 func (api *MessageApi) SendMessage(c *gin.Context) {
 	var (
-		req types.SendMessageReq
+		req  types.SendMessageReq
+		resp types.SendMessageResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	out, err := api.s.MessageRpc.SendMessage(c.Request.Context(), &message.SendMessageReq{
+		UserId:      c.GetInt64("user_id"),
+		Kind:        req.Kind,
+		ToId:        req.ToId,
+		Message:     req.Message,
+		Seq:         req.Seq,
+		Mentions:    req.Mentions,
+		MentionAll:  req.MentionAll,
+		ClientMsgId: req.ClientMsgId,
+		Type:        req.Type,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.SendMessageResp{
+		Id:       out.Id,
+		Seq:      out.Seq,
+		SendTime: out.SendTime,
+	}
+}
+
+func (api *MessageApi) DeleteMessage(c *gin.Context) {
+	var (
+		req types.DeleteMessageReq
 		err error
 	)
 	defer func() {
@@ -150,16 +271,14 @@ func (api *MessageApi) SendMessage(c *gin.Context) {
 			response.Success(c, nil)
 		}
 	}()
-	if err = c.BindJSON(&req); err != nil {
+	if err = c.BindQuery(&req); err != nil {
 		err = errcode.ErrInvalidParam
 		return
 	}
-	_, err = api.s.MessageRpc.SendMessage(c.Request.Context(), &message.SendMessageReq{
-		UserId:  c.GetInt64("user_id"),
-		Kind:    req.Kind,
-		ToId:    req.ToId,
-		Message: req.Message,
-		Seq:     req.Seq,
+	_, err = api.s.MessageRpc.DeleteMessage(c.Request.Context(), &message.DeleteMessageReq{
+		UserId:      c.GetInt64("user_id"),
+		MessageId:   req.MessageId,
+		ForEveryone: req.ForEveryone,
 	})
 	if err != nil {
 		err = errcode.FromRpcError(err)
@@ -197,14 +316,627 @@ func (api *MessageApi) UnreadMessage(c *gin.Context) {
 	infos := make([]types.MessageInfo, 0, len(respRpc.List))
 	for _, item := range respRpc.List {
 		infos = append(infos, types.MessageInfo{
-			Id:      item.Id,
-			Content: item.Content,
-			Seq:     item.Seq,
-			Kind:    item.Kind,
-			FromId:  item.FromId,
+			Id:         item.Id,
+			Content:    item.Content,
+			Seq:        item.Seq,
+			Kind:       item.Kind,
+			FromId:     item.FromId,
+			Mentions:   item.Mentions,
+			MentionAll: item.MentionAll,
+			ReadCount:  item.ReadCount,
+			Type:       item.Type,
+			Revoked:    item.Revoked,
+			ExpireAt:   item.ExpireAt,
 		})
 	}
 	resp = types.ListUnReadMessageResp{
 		List: infos,
 	}
 }
+
+func (api *MessageApi) ListHistory(c *gin.Context) {
+	var (
+		req  types.ListHistoryReq
+		resp types.ListHistoryResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.ListHistory(c.Request.Context(), &message.ListHistoryReq{
+		UserId:    c.GetInt64("user_id"),
+		SessionId: req.SessionId,
+		Seq:       req.Seq,
+		Direction: req.Direction,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.MessageInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.MessageInfo{
+			Id:         item.Id,
+			Content:    item.Content,
+			Seq:        item.Seq,
+			Kind:       item.Kind,
+			FromId:     item.FromId,
+			Mentions:   item.Mentions,
+			MentionAll: item.MentionAll,
+			ReadCount:  item.ReadCount,
+			SendTime:   item.SendTime,
+			FromName:   item.FromName,
+			FromAvatar: item.FromAvatar,
+			Type:       item.Type,
+			Revoked:    item.Revoked,
+			ExpireAt:   item.ExpireAt,
+		})
+	}
+	resp.HasMoreBefore = rpcResp.HasMoreBefore
+	resp.HasMoreAfter = rpcResp.HasMoreAfter
+}
+
+func (api *MessageApi) SearchMessage(c *gin.Context) {
+	var (
+		req  types.SearchMessageReq
+		resp types.SearchMessageResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.SearchMessage(c.Request.Context(), &message.SearchMessageReq{
+		UserId:    c.GetInt64("user_id"),
+		Keyword:   req.Keyword,
+		SessionId: req.SessionId,
+		FromId:    req.FromId,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Offset:    req.Offset,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.SearchMessageHit, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.SearchMessageHit{
+			SessionId: item.SessionId,
+			Kind:      item.Kind,
+			ToId:      item.ToId,
+			Message: types.MessageInfo{
+				Id:         item.Message.Id,
+				Kind:       item.Message.Kind,
+				Content:    item.Message.Content,
+				Seq:        item.Message.Seq,
+				FromId:     item.Message.FromId,
+				SendTime:   item.Message.SendTime,
+				FromName:   item.Message.FromName,
+				FromAvatar: item.Message.FromAvatar,
+			},
+			Snippet: item.Snippet,
+		})
+	}
+}
+
+func (api *MessageApi) ListMessageReader(c *gin.Context) {
+	var (
+		req  types.ListMessageReaderReq
+		resp types.ListMessageReaderResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.ListMessageReader(c.Request.Context(), &message.ListMessageReaderReq{
+		UserId:    c.GetInt64("user_id"),
+		MessageId: req.MessageId,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.Read = make([]types.GroupMember, 0, len(rpcResp.Read))
+	for _, item := range rpcResp.Read {
+		resp.Read = append(resp.Read, types.GroupMember{
+			Id:     item.Id,
+			Name:   item.Name,
+			Avatar: item.Avatar,
+			IsBot:  item.IsBot,
+		})
+	}
+	resp.Unread = make([]types.GroupMember, 0, len(rpcResp.Unread))
+	for _, item := range rpcResp.Unread {
+		resp.Unread = append(resp.Unread, types.GroupMember{
+			Id:     item.Id,
+			Name:   item.Name,
+			Avatar: item.Avatar,
+			IsBot:  item.IsBot,
+		})
+	}
+}
+
+func (api *MessageApi) DeleteSession(c *gin.Context) {
+	var (
+		req types.DeleteSessionReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.DeleteUserSession(c.Request.Context(), &message.DeleteUserSessionReq{
+		UserId:    c.GetInt64("user_id"),
+		SessionId: req.SessionId,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *MessageApi) PinSession(c *gin.Context) {
+	var (
+		req types.PinSessionReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.PinSession(c.Request.Context(), &message.PinSessionReq{
+		UserId:    c.GetInt64("user_id"),
+		SessionId: req.SessionId,
+		Pinned:    req.Pinned,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *MessageApi) MuteSession(c *gin.Context) {
+	var (
+		req types.MuteSessionReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.MuteSession(c.Request.Context(), &message.MuteSessionReq{
+		UserId:    c.GetInt64("user_id"),
+		SessionId: req.SessionId,
+		Muted:     req.Muted,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *MessageApi) ArchiveSession(c *gin.Context) {
+	var (
+		req types.ArchiveSessionReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.ArchiveSession(c.Request.Context(), &message.ArchiveSessionReq{
+		UserId:    c.GetInt64("user_id"),
+		SessionId: req.SessionId,
+		Archived:  req.Archived,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *MessageApi) SetSessionTtl(c *gin.Context) {
+	var (
+		req types.SetSessionTtlReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.SetSessionTtl(c.Request.Context(), &message.SetSessionTtlReq{
+		UserId:    c.GetInt64("user_id"),
+		SessionId: req.SessionId,
+		Ttl:       req.Ttl,
+		Mode:      req.Mode,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *MessageApi) SetSessionEncryption(c *gin.Context) {
+	var (
+		req types.SetSessionEncryptionReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.SetSessionEncryption(c.Request.Context(), &message.SetSessionEncryptionReq{
+		UserId:    c.GetInt64("user_id"),
+		SessionId: req.SessionId,
+		Enabled:   req.Enabled,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *MessageApi) ScheduleMessage(c *gin.Context) {
+	var (
+		req  types.ScheduleMessageReq
+		resp types.ScheduleMessageResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.ScheduleMessage(c.Request.Context(), &message.ScheduleMessageReq{
+		UserId:     c.GetInt64("user_id"),
+		ToId:       req.ToId,
+		Kind:       req.Kind,
+		Message:    req.Message,
+		Type:       req.Type,
+		Mentions:   req.Mentions,
+		MentionAll: req.MentionAll,
+		SendAt:     req.SendAt,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.Id = rpcResp.Id
+}
+
+func (api *MessageApi) ListScheduledMessage(c *gin.Context) {
+	var (
+		resp types.ListScheduledMessageResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	rpcResp, err := api.s.MessageRpc.ListScheduledMessage(c.Request.Context(), &message.ListScheduledMessageReq{
+		UserId: c.GetInt64("user_id"),
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.ScheduledMessageInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.ScheduledMessageInfo{
+			Id:         item.Id,
+			Kind:       item.Kind,
+			ToId:       item.ToId,
+			Type:       item.Type,
+			Message:    item.Message,
+			Mentions:   item.Mentions,
+			MentionAll: item.MentionAll,
+			SendAt:     item.SendAt,
+			Status:     item.Status,
+			Error:      item.Error,
+		})
+	}
+}
+
+func (api *MessageApi) RescheduleMessage(c *gin.Context) {
+	var (
+		req types.RescheduleMessageReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.RescheduleMessage(c.Request.Context(), &message.RescheduleMessageReq{
+		UserId: c.GetInt64("user_id"),
+		Id:     req.Id,
+		SendAt: req.SendAt,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *MessageApi) CancelScheduledMessage(c *gin.Context) {
+	var (
+		req types.CancelScheduledMessageReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.CancelScheduledMessage(c.Request.Context(), &message.CancelScheduledMessageReq{
+		UserId: c.GetInt64("user_id"),
+		Id:     req.Id,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *MessageApi) ForwardMessage(c *gin.Context) {
+	var (
+		req  types.ForwardMessageReq
+		resp types.ForwardMessageResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	targets := make([]*message.ForwardTarget, 0, len(req.Targets))
+	for _, target := range req.Targets {
+		targets = append(targets, &message.ForwardTarget{
+			Kind: target.Kind,
+			ToId: target.ToId,
+		})
+	}
+	rpcResp, err := api.s.MessageRpc.ForwardMessage(c.Request.Context(), &message.ForwardMessageReq{
+		UserId:     c.GetInt64("user_id"),
+		MessageIds: req.MessageIds,
+		Targets:    targets,
+		Merged:     req.Merged,
+		Title:      req.Title,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.ForwardResult, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.ForwardResult{
+			Kind:       item.Kind,
+			ToId:       item.ToId,
+			MessageIds: item.MessageIds,
+			Code:       item.Code,
+			Error:      item.Error,
+		})
+	}
+}
+
+func (api *MessageApi) GetMergedForward(c *gin.Context) {
+	var (
+		req  types.GetMergedForwardReq
+		resp types.GetMergedForwardResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.GetMergedForward(c.Request.Context(), &message.GetMergedForwardReq{
+		UserId:    c.GetInt64("user_id"),
+		MessageId: req.MessageId,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.Title = rpcResp.Title
+	resp.List = make([]types.ForwardedItem, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.ForwardedItem{
+			MessageId:  item.MessageId,
+			FromId:     item.FromId,
+			FromName:   item.FromName,
+			FromAvatar: item.FromAvatar,
+			Type:       item.Type,
+			Content:    item.Content,
+			SendTime:   item.SendTime,
+		})
+	}
+}
+
+func (api *MessageApi) ListBroadcast(c *gin.Context) {
+	var (
+		req  types.ListBroadcastReq
+		resp types.ListBroadcastResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.ListBroadcast(c.Request.Context(), &message.ListBroadcastReq{
+		UserId:   c.GetInt64("user_id"),
+		BeforeId: req.BeforeId,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.BroadcastInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.BroadcastInfo{
+			Id:       item.Id,
+			Title:    item.Title,
+			Content:  item.Content,
+			SendTime: item.SendTime,
+			Read:     item.Read,
+		})
+	}
+}
+
+func (api *MessageApi) ReadBroadcast(c *gin.Context) {
+	var (
+		req types.ReadBroadcastReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.ReadBroadcast(c.Request.Context(), &message.ReadBroadcastReq{
+		UserId: c.GetInt64("user_id"),
+		Id:     req.Id,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+// CardCallback 点击卡片按钮，机器人未响应时返回 ErrBotNoResponse
+func (api *MessageApi) CardCallback(c *gin.Context) {
+	var (
+		req  types.CardCallbackReq
+		resp types.CardCallbackResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	out, err := api.s.MessageRpc.CardCallback(c.Request.Context(), &message.CardCallbackReq{
+		UserId:    c.GetInt64("user_id"),
+		MessageId: req.MessageId,
+		ActionId:  req.ActionId,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.CardCallbackResp{
+		Content: out.Content,
+	}
+}
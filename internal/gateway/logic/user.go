@@ -30,6 +30,19 @@ func (api *UserApi) RegisterRouter(engine *gin.RouterGroup) {
 		auth.GET("/info", api.UserInfo)
 		auth.PUT("/info", api.UpdateInfo)
 		auth.GET("/search", api.SearchUser)
+		auth.GET("/privacy", api.GetPrivacy)
+		auth.PUT("/privacy", api.UpdatePrivacy)
+		auth.POST("/block", api.BlockUser)
+		auth.DELETE("/block", api.UnblockUser)
+		auth.GET("/block", api.ListBlocked)
+		auth.PUT("/keys", api.UploadDeviceKeys)
+		auth.GET("/keys", api.GetPrekeyBundle)
+		auth.GET("/devices", api.ListDevices)
+		auth.DELETE("/devices", api.RevokeDevice)
+		auth.PUT("/push/token", api.RegisterPushToken)
+		auth.DELETE("/push/token", api.UnregisterPushToken)
+		auth.GET("/push/settings", api.GetPushSetting)
+		auth.PUT("/push/settings", api.UpdatePushSetting)
 	}
 }
 
@@ -110,7 +123,8 @@ func (api *UserApi) SearchUser(c *gin.Context) {
 		return
 	}
 	rpcResp, err := api.s.UserRpc.SearchUser(c.Request.Context(), &user.SearchUserReq{
-		Phone: req.Phone,
+		Phone:  req.Phone,
+		UserId: c.GetInt64("user_id"),
 	})
 	if err != nil {
 		err = errcode.FromRpcError(err)
@@ -175,3 +189,373 @@ func (api *UserApi) UserInfo(c *gin.Context) {
 		Gender:   rpcResp.Gender,
 	}
 }
+
+func (api *UserApi) GetPrivacy(c *gin.Context) {
+	var (
+		resp types.PrivacyInfo
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	out, err := api.s.UserRpc.GetPrivacy(c.Request.Context(), &user.GetPrivacyReq{UserId: c.GetInt64("user_id")})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.PrivacyInfo{
+		MessagePolicy: out.MessagePolicy,
+	}
+}
+
+func (api *UserApi) UpdatePrivacy(c *gin.Context) {
+	var (
+		req types.PrivacyInfo
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.UserRpc.UpdatePrivacy(c.Request.Context(), &user.UpdatePrivacyReq{
+		UserId:        c.GetInt64("user_id"),
+		MessagePolicy: req.MessagePolicy,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *UserApi) BlockUser(c *gin.Context) {
+	var (
+		req types.BlockUserReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.UserRpc.BlockUser(c.Request.Context(), &user.BlockUserReq{
+		UserId:    c.GetInt64("user_id"),
+		TargetId:  req.TargetId,
+		HideGroup: req.HideGroup,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *UserApi) UnblockUser(c *gin.Context) {
+	var (
+		req types.UnblockUserReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.UserRpc.UnblockUser(c.Request.Context(), &user.UnblockUserReq{
+		UserId:   c.GetInt64("user_id"),
+		TargetId: req.TargetId,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *UserApi) ListBlocked(c *gin.Context) {
+	var (
+		resp types.ListBlockedResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	rpcResp, err := api.s.UserRpc.ListBlocked(c.Request.Context(), &user.ListBlockedReq{UserId: c.GetInt64("user_id")})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.BlockedUserInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.BlockedUserInfo{
+			UserId:     item.UserId,
+			Username:   item.Username,
+			Avatar:     item.Avatar,
+			HideGroup:  item.HideGroup,
+			CreateTime: item.CreateTime,
+		})
+	}
+}
+
+func (api *UserApi) UploadDeviceKeys(c *gin.Context) {
+	var (
+		req  types.UploadDeviceKeysReq
+		resp types.UploadDeviceKeysResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil || req.SignedPrekey == nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	prekeys := make([]*user.OneTimePrekey, 0, len(req.OneTimePrekeys))
+	for _, item := range req.OneTimePrekeys {
+		prekeys = append(prekeys, &user.OneTimePrekey{KeyId: item.KeyId, PublicKey: item.PublicKey})
+	}
+	rpcResp, err := api.s.UserRpc.UploadDeviceKeys(c.Request.Context(), &user.UploadDeviceKeysReq{
+		UserId:      c.GetInt64("user_id"),
+		DeviceId:    req.DeviceId,
+		IdentityKey: req.IdentityKey,
+		SignedPrekey: &user.SignedPrekey{
+			KeyId:     req.SignedPrekey.KeyId,
+			PublicKey: req.SignedPrekey.PublicKey,
+			Signature: req.SignedPrekey.Signature,
+		},
+		OneTimePrekeys: prekeys,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.PrekeyCount = rpcResp.PrekeyCount
+}
+
+func (api *UserApi) GetPrekeyBundle(c *gin.Context) {
+	var (
+		req  types.GetPrekeyBundleReq
+		resp types.GetPrekeyBundleResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.UserRpc.GetPrekeyBundle(c.Request.Context(), &user.GetPrekeyBundleReq{
+		UserId:   c.GetInt64("user_id"),
+		TargetId: req.UserId,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.PrekeyBundle, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		bundle := types.PrekeyBundle{
+			DeviceId:    item.DeviceId,
+			IdentityKey: item.IdentityKey,
+			SignedPrekey: &types.SignedPrekey{
+				KeyId:     item.SignedPrekey.GetKeyId(),
+				PublicKey: item.SignedPrekey.GetPublicKey(),
+				Signature: item.SignedPrekey.GetSignature(),
+			},
+		}
+		if item.OneTimePrekey != nil {
+			bundle.OneTimePrekey = &types.OneTimePrekey{
+				KeyId:     item.OneTimePrekey.KeyId,
+				PublicKey: item.OneTimePrekey.PublicKey,
+			}
+		}
+		resp.List = append(resp.List, bundle)
+	}
+}
+
+func (api *UserApi) ListDevices(c *gin.Context) {
+	var (
+		resp types.ListDevicesResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	rpcResp, err := api.s.UserRpc.ListDevices(c.Request.Context(), &user.ListDevicesReq{UserId: c.GetInt64("user_id")})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.DeviceInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.DeviceInfo{
+			DeviceId:       item.DeviceId,
+			IdentityKey:    item.IdentityKey,
+			SignedPrekeyId: item.SignedPrekeyId,
+			PrekeyCount:    item.PrekeyCount,
+			UpdateTime:     item.UpdateTime,
+		})
+	}
+}
+
+func (api *UserApi) RevokeDevice(c *gin.Context) {
+	var (
+		req types.RevokeDeviceReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.UserRpc.RevokeDevice(c.Request.Context(), &user.RevokeDeviceReq{
+		UserId:   c.GetInt64("user_id"),
+		DeviceId: req.DeviceId,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *UserApi) RegisterPushToken(c *gin.Context) {
+	var (
+		req types.RegisterPushTokenReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.UserRpc.RegisterPushToken(c.Request.Context(), &user.RegisterPushTokenReq{
+		UserId:   c.GetInt64("user_id"),
+		Platform: req.Platform,
+		Token:    req.Token,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *UserApi) UnregisterPushToken(c *gin.Context) {
+	var (
+		req types.UnregisterPushTokenReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.UserRpc.UnregisterPushToken(c.Request.Context(), &user.UnregisterPushTokenReq{
+		UserId: c.GetInt64("user_id"),
+		Token:  req.Token,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *UserApi) GetPushSetting(c *gin.Context) {
+	var (
+		resp types.PushSetting
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	out, err := api.s.UserRpc.GetPushSetting(c.Request.Context(), &user.GetPushSettingReq{UserId: c.GetInt64("user_id")})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp = types.PushSetting{
+		Preview:    out.Setting.Preview,
+		QuietHours: out.Setting.QuietHours,
+		TzOffset:   out.Setting.TzOffset,
+	}
+}
+
+func (api *UserApi) UpdatePushSetting(c *gin.Context) {
+	var (
+		req types.PushSetting
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.UserRpc.UpdatePushSetting(c.Request.Context(), &user.UpdatePushSettingReq{
+		UserId: c.GetInt64("user_id"),
+		Setting: &user.PushSetting{
+			Preview:    req.Preview,
+			QuietHours: req.QuietHours,
+			TzOffset:   req.TzOffset,
+		},
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
@@ -0,0 +1,161 @@
+package logic
+
+import (
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/middleware/mhttp"
+	"go-im/internal/common/response"
+	"go-im/internal/gateway/server"
+	"go-im/internal/gateway/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookApi struct {
+	s *server.Server
+}
+
+func NewWebhookApi(s *server.Server) *WebhookApi {
+	return &WebhookApi{s}
+}
+
+func (api *WebhookApi) RegisterRouter(engine *gin.RouterGroup) {
+	webhook := engine.Group("/webhook", mhttp.AuthMiddleware())
+	{
+		webhook.POST("", api.CreateWebhook)
+		webhook.GET("", api.ListWebhook)
+		webhook.DELETE("", api.DeleteWebhook)
+		webhook.GET("/delivery", api.ListWebhookDelivery)
+	}
+}
+
+func (api *WebhookApi) CreateWebhook(c *gin.Context) {
+	var (
+		req  types.CreateWebhookReq
+		resp types.CreateWebhookResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindJSON(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.CreateWebhook(c.Request.Context(), &message.CreateWebhookReq{
+		UserId:    c.GetInt64("user_id"),
+		ScopeKind: req.ScopeKind,
+		ScopeId:   req.ScopeId,
+		Url:       req.Url,
+		Events:    req.Events,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.Id = rpcResp.Id
+	resp.Secret = rpcResp.Secret
+}
+
+func (api *WebhookApi) ListWebhook(c *gin.Context) {
+	var (
+		resp types.ListWebhookResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	rpcResp, err := api.s.MessageRpc.ListWebhook(c.Request.Context(), &message.ListWebhookReq{
+		UserId: c.GetInt64("user_id"),
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.WebhookInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.WebhookInfo{
+			Id:         item.Id,
+			ScopeKind:  item.ScopeKind,
+			ScopeId:    item.ScopeId,
+			Url:        item.Url,
+			Events:     item.Events,
+			CreateTime: item.CreateTime,
+		})
+	}
+}
+
+func (api *WebhookApi) DeleteWebhook(c *gin.Context) {
+	var (
+		req types.DeleteWebhookReq
+		err error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, nil)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	_, err = api.s.MessageRpc.DeleteWebhook(c.Request.Context(), &message.DeleteWebhookReq{
+		UserId: c.GetInt64("user_id"),
+		Id:     req.Id,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+	}
+}
+
+func (api *WebhookApi) ListWebhookDelivery(c *gin.Context) {
+	var (
+		req  types.ListWebhookDeliveryReq
+		resp types.ListWebhookDeliveryResp
+		err  error
+	)
+	defer func() {
+		if err != nil {
+			response.Error(c, err)
+		} else {
+			response.Success(c, resp)
+		}
+	}()
+	if err = c.BindQuery(&req); err != nil {
+		err = errcode.ErrInvalidParam
+		return
+	}
+	rpcResp, err := api.s.MessageRpc.ListWebhookDelivery(c.Request.Context(), &message.ListWebhookDeliveryReq{
+		UserId: c.GetInt64("user_id"),
+		Id:     req.Id,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		err = errcode.FromRpcError(err)
+		return
+	}
+	resp.List = make([]types.WebhookDelivery, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		resp.List = append(resp.List, types.WebhookDelivery{
+			Id:         item.Id,
+			EventId:    item.EventId,
+			EventType:  item.EventType,
+			Attempt:    item.Attempt,
+			StatusCode: item.StatusCode,
+			Error:      item.Error,
+			DurationMs: item.DurationMs,
+			Result:     item.Result,
+			CreateTime: item.CreateTime,
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 type Server struct {
 	UserRpc    user.UserClient
 	MessageRpc message.MessageClient
+	AdminIds   []int64
 }
 
 func NewServer(c *config.Config) *Server {
@@ -42,5 +43,6 @@ func NewServer(c *config.Config) *Server {
 	return &Server{
 		UserRpc:    user.NewUserClient(userConn),
 		MessageRpc: message.NewMessageClient(messageConn),
+		AdminIds:   c.AdminIds,
 	}
 }
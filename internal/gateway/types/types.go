@@ -83,6 +83,7 @@ type FriendInfo struct {
 	Avatar   string `json:"avatar"`
 	Gender   string `json:"gender"`
 	Remark   string `json:"remark"`
+	IsBot    bool   `json:"isBot"`
 }
 
 type GroupInfo struct {
@@ -98,6 +99,7 @@ type GroupMember struct {
 	Id     int64  `json:"id"`
 	Name   string `json:"name"`
 	Avatar string `json:"avatar"`
+	IsBot  bool   `json:"isBot"`
 }
 
 type HandleApplyReq struct {
@@ -167,6 +169,23 @@ type ListSessionResp struct {
 	List []SessionInfo `json:"list"`
 }
 
+type SessionUnread struct {
+	// system 为公告，sessionId 为 0
+	SessionId int64  `json:"sessionId"`
+	Kind      string `json:"kind"`
+	ToId      int64  `json:"toId"`
+	Unread    int64  `json:"unread"`
+	Mentions  int64  `json:"mentions"`
+	Muted     bool   `json:"muted"`
+}
+
+type UnreadSummaryResp struct {
+	// 角标数，不含免打扰会话
+	Total    int64           `json:"total"`
+	Mentions int64           `json:"mentions"`
+	List     []SessionUnread `json:"list"`
+}
+
 type ListUnReadMessageReq struct {
 	FromId  int64  `form:"fromId,optional"`
 	GroupId int64  `form:"groupId,optional"`
@@ -188,11 +207,69 @@ type LoginResp struct {
 }
 
 type MessageInfo struct {
-	Id      int64  `json:"id"`
-	Kind    string `json:"kind"`
-	Content string `json:"content"`
-	Seq     int64  `json:"seq"`
-	FromId  int64  `json:"fromId"`
+	Id         int64   `json:"id"`
+	Kind       string  `json:"kind"`
+	Content    string  `json:"content"`
+	Seq        int64   `json:"seq"`
+	FromId     int64   `json:"fromId"`
+	Mentions   []int64 `json:"mentions,omitempty"`
+	MentionAll bool    `json:"mentionAll,omitempty"`
+	ReadCount  int64   `json:"readCount"`
+	SendTime   int64   `json:"sendTime,omitempty"`
+	FromName   string  `json:"fromName,omitempty"`
+	FromAvatar string  `json:"fromAvatar,omitempty"`
+	Type       string  `json:"type,omitempty"`
+	Revoked    bool    `json:"revoked,omitempty"`
+	ExpireAt   int64   `json:"expireAt,omitempty"`
+}
+
+type DeleteMessageReq struct {
+	MessageId   int64 `form:"messageId"`
+	ForEveryone bool  `form:"forEveryone,optional"`
+}
+
+type ListHistoryReq struct {
+	SessionId int64  `form:"sessionId"`
+	Seq       int64  `form:"seq,optional"`
+	Direction string `form:"direction,optional"`
+	Limit     int64  `form:"limit,optional"`
+}
+
+type SearchMessageReq struct {
+	Keyword   string `form:"keyword"`
+	SessionId int64  `form:"sessionId,optional"`
+	FromId    int64  `form:"fromId,optional"`
+	StartTime int64  `form:"startTime,optional"`
+	EndTime   int64  `form:"endTime,optional"`
+	Offset    int64  `form:"offset,optional"`
+	Limit     int64  `form:"limit,optional"`
+}
+
+type SearchMessageHit struct {
+	SessionId int64       `json:"sessionId"`
+	Kind      string      `json:"kind"`
+	ToId      int64       `json:"toId"`
+	Message   MessageInfo `json:"message"`
+	Snippet   string      `json:"snippet"`
+}
+
+type SearchMessageResp struct {
+	List []SearchMessageHit `json:"list"`
+}
+
+type ListHistoryResp struct {
+	List          []MessageInfo `json:"list"`
+	HasMoreBefore bool          `json:"hasMoreBefore"`
+	HasMoreAfter  bool          `json:"hasMoreAfter"`
+}
+
+type ListMessageReaderReq struct {
+	MessageId int64 `form:"messageId"`
+}
+
+type ListMessageReaderResp struct {
+	Read   []GroupMember `json:"read"`
+	Unread []GroupMember `json:"unread"`
 }
 
 type MoveOutMemberReq struct {
@@ -249,27 +326,87 @@ type SearchUserResp struct {
 }
 
 type SendMessageReq struct {
-	Kind    string `json:"kind"`
-	ToId    int64  `json:"toId"`
-	Message string `json:"message"`
-	Seq     int64  `json:"seq"`
+	Kind        string  `json:"kind"`
+	ToId        int64   `json:"toId"`
+	Message     string  `json:"message"`
+	Seq         int64   `json:"seq"`
+	Mentions    []int64 `json:"mentions"`
+	MentionAll  bool    `json:"mentionAll"`
+	ClientMsgId string  `json:"clientMsgId"`
+	Type        string  `json:"type"`
 }
 
 type SendMessageResp struct {
+	Id       int64 `json:"id"`
+	Seq      int64 `json:"seq"`
+	SendTime int64 `json:"sendTime"`
 }
 
 type SessionInfo struct {
-	SessionId    int64  `json:"sessionId"`
-	Kind         string `json:"kind"`
-	Avatar       string `json:"avatar"`
-	GroupId      int64  `json:"groupId"`
-	GroupName    string `json:"groupName,omitempty"`
-	GroupAvatar  string `json:"groupAvatar,omitempty"`
-	MemberCount  int64  `json:"memberCount,omitempty"`
-	FriendId     int64  `json:"friendId"`
-	FrienName    string `json:"friendName"`
-	FriendAvatar string `json:"friendAvatar,omitempty"`
-	Seq          int64  `json:"seq"`
+	SessionId      int64        `json:"sessionId"`
+	Kind           string       `json:"kind"`
+	Avatar         string       `json:"avatar"`
+	GroupId        int64        `json:"groupId"`
+	GroupName      string       `json:"groupName,omitempty"`
+	GroupAvatar    string       `json:"groupAvatar,omitempty"`
+	MemberCount    int64        `json:"memberCount,omitempty"`
+	FriendId       int64        `json:"friendId"`
+	FrienName      string       `json:"friendName"`
+	FriendAvatar   string       `json:"friendAvatar,omitempty"`
+	Seq            int64        `json:"seq"`
+	UnreadMentions int64        `json:"unreadMentions"`
+	UnreadCount    int64        `json:"unreadCount"`
+	LastMessage    *LastMessage `json:"lastMessage,omitempty"`
+	Pinned         bool         `json:"pinned"`
+	PinnedAt       int64        `json:"pinnedAt,omitempty"`
+	Muted          bool         `json:"muted"`
+	MutedAt        int64        `json:"mutedAt,omitempty"`
+	Archived       bool         `json:"archived"`
+	ArchivedAt     int64        `json:"archivedAt,omitempty"`
+	Ttl            int64        `json:"ttl,omitempty"`
+	TtlMode        string       `json:"ttlMode,omitempty"`
+	Encrypted      bool         `json:"encrypted"`
+}
+
+type LastMessage struct {
+	Id       int64  `json:"id"`
+	Seq      int64  `json:"seq"`
+	FromId   int64  `json:"fromId"`
+	FromName string `json:"fromName"`
+	Type     string `json:"type"`
+	Excerpt  string `json:"excerpt"`
+	SendTime int64  `json:"sendTime"`
+	Revoked  bool   `json:"revoked,omitempty"`
+}
+
+type DeleteSessionReq struct {
+	SessionId int64 `form:"sessionId"`
+}
+
+type PinSessionReq struct {
+	SessionId int64 `json:"sessionId"`
+	Pinned    bool  `json:"pinned"`
+}
+
+type MuteSessionReq struct {
+	SessionId int64 `json:"sessionId"`
+	Muted     bool  `json:"muted"`
+}
+
+type ArchiveSessionReq struct {
+	SessionId int64 `json:"sessionId"`
+	Archived  bool  `json:"archived"`
+}
+
+type SetSessionTtlReq struct {
+	SessionId int64  `json:"sessionId"`
+	Ttl       int64  `json:"ttl"`
+	Mode      string `json:"mode"`
+}
+
+type SetSessionEncryptionReq struct {
+	SessionId int64 `json:"sessionId"`
+	Enabled   bool  `json:"enabled"`
 }
 
 type UpdateGroupInfoReq struct {
@@ -286,9 +423,139 @@ type UpdateInfoReq struct {
 	Avatar   string `json:"avatar"`
 }
 
+type PrivacyInfo struct {
+	// friends: 仅好友; limited: 陌生人在回复前限制条数; open: 不限制; 为空使用默认策略
+	MessagePolicy string `json:"messagePolicy"`
+}
+
+type BlockUserReq struct {
+	TargetId int64 `json:"targetId"`
+	// 同时隐藏对方在群里发的消息
+	HideGroup bool `json:"hideGroup"`
+}
+
+type UnblockUserReq struct {
+	TargetId int64 `form:"targetId"`
+}
+
+type BlockedUserInfo struct {
+	UserId     int64  `json:"userId"`
+	Username   string `json:"username"`
+	Avatar     string `json:"avatar"`
+	HideGroup  bool   `json:"hideGroup"`
+	CreateTime int64  `json:"createTime"`
+}
+
+type ListBlockedResp struct {
+	List []BlockedUserInfo `json:"list"`
+}
+
 type UpdateInfoResp struct {
 }
 
+// 公钥和签名均为 base64 编码
+type SignedPrekey struct {
+	KeyId     int64  `json:"keyId"`
+	PublicKey string `json:"publicKey"`
+	Signature string `json:"signature"`
+}
+
+type OneTimePrekey struct {
+	KeyId     int64  `json:"keyId"`
+	PublicKey string `json:"publicKey"`
+}
+
+type UploadDeviceKeysReq struct {
+	DeviceId       string          `json:"deviceId"`
+	IdentityKey    string          `json:"identityKey"`
+	SignedPrekey   *SignedPrekey   `json:"signedPrekey"`
+	OneTimePrekeys []OneTimePrekey `json:"oneTimePrekeys"`
+}
+
+type UploadDeviceKeysResp struct {
+	PrekeyCount int64 `json:"prekeyCount"`
+}
+
+type GetPrekeyBundleReq struct {
+	UserId int64 `form:"userId"`
+}
+
+type PrekeyBundle struct {
+	DeviceId      string         `json:"deviceId"`
+	IdentityKey   string         `json:"identityKey"`
+	SignedPrekey  *SignedPrekey  `json:"signedPrekey"`
+	OneTimePrekey *OneTimePrekey `json:"oneTimePrekey,omitempty"`
+}
+
+type GetPrekeyBundleResp struct {
+	List []PrekeyBundle `json:"list"`
+}
+
+type DeviceInfo struct {
+	DeviceId       string `json:"deviceId"`
+	IdentityKey    string `json:"identityKey"`
+	SignedPrekeyId int64  `json:"signedPrekeyId"`
+	PrekeyCount    int64  `json:"prekeyCount"`
+	UpdateTime     int64  `json:"updateTime"`
+}
+
+type ListDevicesResp struct {
+	List []DeviceInfo `json:"list"`
+}
+
+type RevokeDeviceReq struct {
+	DeviceId string `form:"deviceId"`
+}
+
+type RegisterPushTokenReq struct {
+	// ios / android
+	Platform string `json:"platform"`
+	Token    string `json:"token"`
+}
+
+type UnregisterPushTokenReq struct {
+	Token string `form:"token"`
+}
+
+type PushSetting struct {
+	// full: 显示发送者和内容; sender: 只显示发送者; hidden: 只提示有新消息
+	Preview string `json:"preview"`
+	// 免打扰时段，如 22:00-07:00，为空表示不设置
+	QuietHours string `json:"quietHours"`
+	// 时区相对 UTC 的分钟数，如东八区为 480
+	TzOffset int32 `json:"tzOffset"`
+}
+
+type CreateExportReq struct {
+	SessionId int64 `json:"sessionId"`
+	// jsonl / csv / html
+	Format string `json:"format"`
+	// 毫秒时间戳，0 表示不限制
+	StartTime int64 `json:"startTime"`
+	EndTime   int64 `json:"endTime"`
+}
+
+type CreateExportResp struct {
+	Id int64 `json:"id"`
+}
+
+type GetExportReq struct {
+	Id int64 `form:"id"`
+}
+
+type GetExportResp struct {
+	Id           int64  `json:"id"`
+	SessionId    int64  `json:"sessionId"`
+	Format       string `json:"format"`
+	Status       string `json:"status"`
+	MessageCount int64  `json:"messageCount"`
+	FileSize     int64  `json:"fileSize"`
+	Error        string `json:"error"`
+	CreateTime   int64  `json:"createTime"`
+	// 导出完成后才有
+	DownloadUrl string `json:"downloadUrl"`
+}
+
 type UploadReq struct {
 }
 
@@ -320,3 +587,266 @@ type UpdateFriendInfoReq struct {
 }
 
 type UpdateFriendInfoResp struct{}
+
+type ScheduleMessageReq struct {
+	Kind       string  `json:"kind"`
+	ToId       int64   `json:"toId"`
+	Message    string  `json:"message"`
+	Type       string  `json:"type"`
+	Mentions   []int64 `json:"mentions"`
+	MentionAll bool    `json:"mentionAll"`
+	SendAt     int64   `json:"sendAt"`
+}
+
+type ScheduleMessageResp struct {
+	Id int64 `json:"id"`
+}
+
+type ScheduledMessageInfo struct {
+	Id         int64   `json:"id"`
+	Kind       string  `json:"kind"`
+	ToId       int64   `json:"toId"`
+	Type       string  `json:"type"`
+	Message    string  `json:"message"`
+	Mentions   []int64 `json:"mentions,omitempty"`
+	MentionAll bool    `json:"mentionAll,omitempty"`
+	SendAt     int64   `json:"sendAt"`
+	Status     string  `json:"status"`
+	Error      string  `json:"error,omitempty"`
+}
+
+type ListScheduledMessageResp struct {
+	List []ScheduledMessageInfo `json:"list"`
+}
+
+type CancelScheduledMessageReq struct {
+	Id int64 `form:"id"`
+}
+
+type RescheduleMessageReq struct {
+	Id     int64 `json:"id"`
+	SendAt int64 `json:"sendAt"`
+}
+
+type ForwardTarget struct {
+	Kind string `json:"kind"`
+	ToId int64  `json:"toId"`
+}
+
+type ForwardMessageReq struct {
+	MessageIds []int64         `json:"messageIds"`
+	Targets    []ForwardTarget `json:"targets"`
+	Merged     bool            `json:"merged"`
+	Title      string          `json:"title"`
+}
+
+type ForwardResult struct {
+	Kind       string  `json:"kind"`
+	ToId       int64   `json:"toId"`
+	MessageIds []int64 `json:"messageIds"`
+	Code       int64   `json:"code"`
+	Error      string  `json:"error,omitempty"`
+}
+
+type ForwardMessageResp struct {
+	List []ForwardResult `json:"list"`
+}
+
+type GetMergedForwardReq struct {
+	MessageId int64 `form:"messageId"`
+}
+
+type ForwardedItem struct {
+	MessageId  int64  `json:"messageId"`
+	FromId     int64  `json:"fromId"`
+	FromName   string `json:"fromName"`
+	FromAvatar string `json:"fromAvatar"`
+	Type       string `json:"type"`
+	Content    string `json:"content"`
+	SendTime   int64  `json:"sendTime"`
+}
+
+type GetMergedForwardResp struct {
+	Title string          `json:"title"`
+	List  []ForwardedItem `json:"list"`
+}
+
+type CreateBroadcastReq struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	// all / registered_after / groups
+	Segment string `json:"segment"`
+	// 毫秒时间戳
+	RegisteredAfter int64   `json:"registeredAfter"`
+	GroupIds        []int64 `json:"groupIds"`
+}
+
+type CreateBroadcastResp struct {
+	Id int64 `json:"id"`
+}
+
+type GetBroadcastStatsReq struct {
+	Id int64 `form:"id"`
+}
+
+type GetBroadcastStatsResp struct {
+	Id             int64  `json:"id"`
+	Title          string `json:"title"`
+	Segment        string `json:"segment"`
+	Status         string `json:"status"`
+	TotalCount     int64  `json:"totalCount"`
+	DeliveredCount int64  `json:"deliveredCount"`
+	ReadCount      int64  `json:"readCount"`
+	CreateTime     int64  `json:"createTime"`
+}
+
+type ListBroadcastReq struct {
+	BeforeId int64 `form:"beforeId"`
+	Limit    int64 `form:"limit"`
+}
+
+type BroadcastInfo struct {
+	Id       int64  `json:"id"`
+	Title    string `json:"title"`
+	Content  string `json:"content"`
+	SendTime int64  `json:"sendTime"`
+	Read     bool   `json:"read"`
+}
+
+type ListBroadcastResp struct {
+	List []BroadcastInfo `json:"list"`
+}
+
+type ReadBroadcastReq struct {
+	Id int64 `json:"id"`
+}
+
+type CardCallbackReq struct {
+	MessageId int64  `json:"messageId"`
+	ActionId  string `json:"actionId"`
+}
+
+type CardCallbackResp struct {
+	// 机器人更新了卡片时返回新内容，为空表示卡片未变化
+	Content string `json:"content"`
+}
+
+type CreateWebhookReq struct {
+	// group / user
+	ScopeKind string   `json:"scopeKind"`
+	ScopeId   int64    `json:"scopeId"`
+	Url       string   `json:"url"`
+	Events    []string `json:"events"`
+}
+
+type CreateWebhookResp struct {
+	Id     int64  `json:"id"`
+	Secret string `json:"secret"`
+}
+
+type WebhookInfo struct {
+	Id         int64    `json:"id"`
+	ScopeKind  string   `json:"scopeKind"`
+	ScopeId    int64    `json:"scopeId"`
+	Url        string   `json:"url"`
+	Events     []string `json:"events"`
+	CreateTime int64    `json:"createTime"`
+}
+
+type ListWebhookResp struct {
+	List []WebhookInfo `json:"list"`
+}
+
+type DeleteWebhookReq struct {
+	Id int64 `form:"id"`
+}
+
+type ListWebhookDeliveryReq struct {
+	Id    int64 `form:"id"`
+	Limit int64 `form:"limit"`
+}
+
+type WebhookDelivery struct {
+	Id         int64  `json:"id"`
+	EventId    string `json:"eventId"`
+	EventType  string `json:"eventType"`
+	Attempt    int32  `json:"attempt"`
+	StatusCode int32  `json:"statusCode"`
+	Error      string `json:"error"`
+	DurationMs int64  `json:"durationMs"`
+	Result     string `json:"result"`
+	CreateTime int64  `json:"createTime"`
+}
+
+type ListWebhookDeliveryResp struct {
+	List []WebhookDelivery `json:"list"`
+}
+
+type CreateBotReq struct {
+	Username string `json:"username"`
+	Avatar   string `json:"avatar"`
+}
+
+type CreateBotResp struct {
+	BotId int64  `json:"botId"`
+	Token string `json:"token"`
+}
+
+type BotInfo struct {
+	BotId      int64  `json:"botId"`
+	Username   string `json:"username"`
+	Avatar     string `json:"avatar"`
+	CreateTime int64  `json:"createTime"`
+}
+
+type ListBotResp struct {
+	List []BotInfo `json:"list"`
+}
+
+type CreateBotTokenReq struct {
+	BotId int64  `json:"botId"`
+	Name  string `json:"name"`
+}
+
+type CreateBotTokenResp struct {
+	Id    int64  `json:"id"`
+	Token string `json:"token"`
+}
+
+type ListBotTokenReq struct {
+	BotId int64 `form:"botId"`
+}
+
+type BotTokenInfo struct {
+	Id         int64  `json:"id"`
+	Name       string `json:"name"`
+	LastUsedAt int64  `json:"lastUsedAt"`
+	Revoked    bool   `json:"revoked"`
+	CreateTime int64  `json:"createTime"`
+}
+
+type ListBotTokenResp struct {
+	List []BotTokenInfo `json:"list"`
+}
+
+type RevokeBotTokenReq struct {
+	BotId int64 `form:"botId"`
+	Id    int64 `form:"id"`
+}
+
+type BotSendMessageReq struct {
+	GroupId     int64   `json:"groupId"`
+	Message     string  `json:"message"`
+	Mentions    []int64 `json:"mentions"`
+	ClientMsgId string  `json:"clientMsgId"`
+	Type        string  `json:"type"`
+}
+
+type BotUpdateCardReq struct {
+	MessageId int64  `json:"messageId"`
+	Content   string `json:"content"`
+}
+
+type BotUpdateCardResp struct {
+	Version int32 `json:"version"`
+}
@@ -1,11 +1,14 @@
 package config
 
 import (
+	"go-im/internal/message/pkg/search"
 	"go-im/internal/pkg/db"
 	"go-im/internal/pkg/kafka"
 	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/moderation"
 	"go-im/internal/pkg/mprometheus"
 	"go-im/internal/pkg/mtrace"
+	"go-im/internal/pkg/push"
 	"go-im/internal/pkg/redis"
 	"go-im/internal/pkg/server/rpc"
 	"os"
@@ -24,6 +27,34 @@ type Config struct {
 	UserClient   rpc.ClientConfig   `yaml:"user_client"`
 	AccessClient rpc.ClientConfig   `yaml:"access_client"`
 	Prometheus   mprometheus.Config `yaml:"prometheus"`
+
+	// 已读回执合并推送间隔，单位毫秒
+	ReceiptInterval int `yaml:"receipt_interval"`
+
+	Search search.Config `yaml:"search"`
+
+	// 发送者对所有人删除消息的时限，单位秒
+	RevokeWindow int `yaml:"revoke_window"`
+
+	Moderation moderation.Config `yaml:"moderation"`
+
+	// 单聊接收策略：friends / limited / open，用户可在隐私设置中覆盖
+	StrangerPolicy string `yaml:"stranger_policy"`
+	// limited 策略下对方回复前陌生人最多发送的消息数
+	StrangerLimit int `yaml:"stranger_limit"`
+
+	Export ExportConfig `yaml:"export"`
+
+	// 离线推送，未配置时使用只打印日志的 fake 通道
+	Push push.Config `yaml:"push"`
+
+	// 未读计数按库中序号校正的间隔，单位分钟
+	UnreadRepairInterval int `yaml:"unread_repair_interval"`
+}
+
+type ExportConfig struct {
+	// 导出文件保留时长，单位小时
+	Retention int `yaml:"retention"`
 }
 
 func ParseConfig(file string) *Config {
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	BroadcastSegmentAll             = "all"
+	BroadcastSegmentRegisteredAfter = "registered_after"
+	BroadcastSegmentGroups          = "groups"
+
+	BroadcastStatusPending = "pending"
+	BroadcastStatusSending = "sending"
+	BroadcastStatusSent    = "sent"
+)
+
+// 系统公告，创建后由后台按人群分批写入 BroadcastReceipt
+type Broadcast struct {
+	ID              int64      `gorm:"id" json:"id"`
+	OperatorId      int64      `gorm:"operator_id" json:"operator_id"`
+	Title           string     `gorm:"title" json:"title"`
+	Content         string     `gorm:"content" json:"content"`
+	Segment         string     `gorm:"segment" json:"segment"`
+	RegisteredAfter *time.Time `gorm:"registered_after" json:"registered_after"`
+	// 群组ID列表的 JSON
+	GroupIds string `gorm:"group_ids" json:"group_ids"`
+	Status   string `gorm:"status" json:"status"`
+	// 已写入回执的最大用户ID，投递中断后从这里继续
+	LastUserId     int64 `gorm:"last_user_id" json:"last_user_id"`
+	TotalCount     int64 `gorm:"total_count" json:"total_count"`
+	DeliveredCount int64 `gorm:"delivered_count" json:"delivered_count"`
+	ReadCount      int64 `gorm:"read_count" json:"read_count"`
+	gorm.Model
+}
+
+func (b Broadcast) TableName() string {
+	return "broadcast"
+}
+
+// 每个目标用户一条，推送到在线设备或用户拉取会话列表时记为送达
+type BroadcastReceipt struct {
+	ID          int64      `gorm:"id" json:"id"`
+	BroadcastId int64      `gorm:"broadcast_id" json:"broadcast_id"`
+	UserId      int64      `gorm:"user_id" json:"user_id"`
+	DeliveredAt *time.Time `gorm:"delivered_at" json:"delivered_at"`
+	ReadAt      *time.Time `gorm:"read_at" json:"read_at"`
+	gorm.Model
+}
+
+func (br BroadcastReceipt) TableName() string {
+	return "broadcast_receipt"
+}
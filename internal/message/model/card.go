@@ -0,0 +1,58 @@
+package model
+
+import "errors"
+
+const (
+	maxCardTitle   = 100
+	maxCardFields  = 10
+	maxCardActions = 5
+)
+
+var ErrInvalidCard = errors.New("invalid card")
+
+// Card 机器人发送的卡片消息内容，按钮点击后回调给机器人
+type Card struct {
+	Title   string       `json:"title"`
+	Fields  []CardField  `json:"fields,omitempty"`
+	Actions []CardAction `json:"actions,omitempty"`
+	// 每次更新加一，客户端据此丢弃过期的更新
+	Version int `json:"version"`
+}
+
+type CardField struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+type CardAction struct {
+	Id    string `json:"id"`
+	Text  string `json:"text"`
+	Value string `json:"value,omitempty"`
+}
+
+// Validate 校验标题、字段和按钮数量，按钮ID不能重复
+func (c *Card) Validate() error {
+	if c.Title == "" || len([]rune(c.Title)) > maxCardTitle {
+		return ErrInvalidCard
+	}
+	if len(c.Fields) > maxCardFields || len(c.Actions) > maxCardActions {
+		return ErrInvalidCard
+	}
+	seen := make(map[string]bool, len(c.Actions))
+	for _, a := range c.Actions {
+		if a.Id == "" || a.Text == "" || seen[a.Id] {
+			return ErrInvalidCard
+		}
+		seen[a.Id] = true
+	}
+	return nil
+}
+
+func (c *Card) Action(id string) *CardAction {
+	for i := range c.Actions {
+		if c.Actions[i].Id == id {
+			return &c.Actions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package model
+
+import "gorm.io/gorm"
+
+// 会话级别的序号分配，单聊双方共用一条记录
+type Conversation struct {
+	ID        int64  `gorm:"id" json:"id"`
+	Kind      string `gorm:"kind" json:"kind"`
+	FromId    int64  `gorm:"from_id" json:"from_id"`
+	ToId      int64  `gorm:"to_id" json:"to_id"`
+	Seq       int64  `gorm:"seq" json:"seq"`
+	LastMsgId int64  `gorm:"last_msg_id" json:"last_msg_id"`
+	// 阅后即焚设置，对之后发送的消息生效
+	Ttl     int64  `gorm:"ttl" json:"ttl"`
+	TtlMode string `gorm:"ttl_mode" json:"ttl_mode"`
+	// 单聊端到端加密，开启后只接受加密消息
+	Encrypted bool `gorm:"encrypted" json:"encrypted"`
+	gorm.Model
+}
+
+const (
+	TtlModeSent = "sent"
+	TtlModeRead = "read"
+)
+
+func (c Conversation) TableName() string {
+	return "conversation"
+}
+
+// 单聊按 (较小ID, 较大ID) 归一，群聊为 (0, 群ID)
+func ConversationKey(kind string, fromId, toId int64) (int64, int64) {
+	if kind == "group" {
+		return 0, toId
+	}
+	return min(fromId, toId), max(fromId, toId)
+}
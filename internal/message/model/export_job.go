@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ExportStatusPending = "pending"
+	ExportStatusRunning = "running"
+	ExportStatusDone    = "done"
+	ExportStatusFailed  = "failed"
+	// 文件超过保留期后被清理
+	ExportStatusExpired = "expired"
+)
+
+// ExportJob 会话导出任务，由后台生成文件后通过网关下载
+type ExportJob struct {
+	ID int64 `gorm:"id" json:"id"`
+	// 发起人，只有发起人可以查看和下载
+	UserId    int64  `gorm:"user_id" json:"user_id"`
+	SessionId int64  `gorm:"session_id" json:"session_id"`
+	Kind      string `gorm:"kind" json:"kind"`
+	// 会话所属用户，合规导出时可以不是发起人
+	OwnerId int64  `gorm:"owner_id" json:"owner_id"`
+	ToId    int64  `gorm:"to_id" json:"to_id"`
+	Format  string `gorm:"format" json:"format"`
+	// 为空表示不限制
+	StartTime *time.Time `gorm:"start_time" json:"start_time"`
+	EndTime   *time.Time `gorm:"end_time" json:"end_time"`
+	// 合规导出包含用户已清空和仅自己删除的消息
+	Compliance   bool   `gorm:"compliance" json:"compliance"`
+	Status       string `gorm:"status" json:"status"`
+	MessageCount int64  `gorm:"message_count" json:"message_count"`
+	FileSize     int64  `gorm:"file_size" json:"file_size"`
+	Error        string `gorm:"error" json:"error"`
+	gorm.Model
+}
+
+func (e ExportJob) TableName() string {
+	return "export_job"
+}
+
+// ExportChunk 导出文件按块存库，任意副本都可以下载和清理
+type ExportChunk struct {
+	ID    int64  `gorm:"id" json:"id"`
+	JobId int64  `gorm:"job_id" json:"job_id"`
+	Idx   int    `gorm:"idx" json:"idx"`
+	Data  []byte `gorm:"data" json:"data"`
+	gorm.Model
+}
+
+func (e ExportChunk) TableName() string {
+	return "export_chunk"
+}
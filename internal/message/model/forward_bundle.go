@@ -0,0 +1,35 @@
+package model
+
+import "gorm.io/gorm"
+
+// 合并转发的聊天记录快照，原消息删除后仍可查看
+type ForwardBundle struct {
+	ID     int64  `gorm:"id" json:"id"`
+	UserId int64  `gorm:"user_id" json:"user_id"`
+	Title  string `gorm:"title" json:"title"`
+	// ForwardItem 列表的 JSON
+	Items string `gorm:"items" json:"items"`
+	gorm.Model
+}
+
+func (fb ForwardBundle) TableName() string {
+	return "forward_bundle"
+}
+
+type ForwardItem struct {
+	MessageId  int64  `json:"message_id"`
+	FromId     int64  `json:"from_id"`
+	FromName   string `json:"from_name"`
+	FromAvatar string `json:"from_avatar"`
+	Type       string `json:"type"`
+	Content    string `json:"content"`
+	SendTime   int64  `json:"send_time"`
+}
+
+// 合并转发消息的内容，完整记录通过 bundle_id 查询
+type MergedCard struct {
+	BundleId int64    `json:"bundle_id"`
+	Title    string   `json:"title"`
+	Count    int      `json:"count"`
+	Preview  []string `json:"preview"`
+}
@@ -1,17 +1,51 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
 
 type Message struct {
-	ID      int64  `gorm:"id" json:"id"`
-	FromId  int64  `gorm:"from_id" json:"from_id"`
-	ToId    int64  `gorm:"to_id" json:"to_id"`
-	Content string `gorm:"content" json:"content"`
-	Seq     int64  `gorm:"seq" json:"seq"`
-	Kind    string `gorm:"kind" json:"kind"`
+	ID        int64  `gorm:"id" json:"id"`
+	FromId    int64  `gorm:"from_id" json:"from_id"`
+	ToId      int64  `gorm:"to_id" json:"to_id"`
+	Content   string `gorm:"content" json:"content"`
+	Seq       int64  `gorm:"seq" json:"seq"`
+	ClientSeq int64  `gorm:"client_seq" json:"client_seq"`
+	// 为空时存 NULL，不参与唯一索引
+	ClientMsgId *string `gorm:"client_msg_id" json:"client_msg_id"`
+	Kind        string  `gorm:"kind" json:"kind"`
+	// text、image 等，由客户端决定如何展示
+	Type string `gorm:"column:type;default:text" json:"type"`
+	// 对所有人删除后只保留墓碑，内容清空
+	RevokedAt *time.Time `gorm:"revoked_at" json:"revoked_at"`
+	RevokedBy int64      `gorm:"revoked_by" json:"revoked_by"`
+	// 阅后即焚时长（秒），read 模式下 expire_at 在对方已读后才设置
+	Ttl      int64      `gorm:"ttl" json:"ttl"`
+	ExpireAt *time.Time `gorm:"expire_at" json:"expire_at"`
 	gorm.Model
 }
 
+const (
+	// 系统消息由服务端生成，客户端不能发送
+	MessageTypeSystem = "system"
+	// 合并转发的聊天记录卡片
+	MessageTypeMerged = "merged"
+	// 机器人发送的交互卡片，内容为 Card
+	MessageTypeCard = "card"
+	// 端到端加密消息，内容为客户端生成的密文，服务端不解析
+	MessageTypeEncrypted = "encrypted"
+)
+
 func (m Message) TableName() string {
 	return "message"
 }
+
+// 唯一索引冲突
+func IsDuplicateKey(err error) bool {
+	var e *mysql.MySQLError
+	return errors.As(err, &e) && e.Number == 1062
+}
@@ -0,0 +1,15 @@
+package model
+
+import "gorm.io/gorm"
+
+// 仅对自己删除的消息
+type MessageDeletion struct {
+	ID        int64 `gorm:"id" json:"id"`
+	MessageId int64 `gorm:"message_id" json:"message_id"`
+	UserId    int64 `gorm:"user_id" json:"user_id"`
+	gorm.Model
+}
+
+func (md MessageDeletion) TableName() string {
+	return "message_deletion"
+}
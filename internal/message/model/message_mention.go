@@ -0,0 +1,18 @@
+package model
+
+import "gorm.io/gorm"
+
+// UserId 为 0 表示@所有人
+type MessageMention struct {
+	ID        int64 `gorm:"id" json:"id"`
+	MessageId int64 `gorm:"message_id" json:"message_id"`
+	GroupId   int64 `gorm:"group_id" json:"group_id"`
+	FromId    int64 `gorm:"from_id" json:"from_id"`
+	UserId    int64 `gorm:"user_id" json:"user_id"`
+	Seq       int64 `gorm:"seq" json:"seq"`
+	gorm.Model
+}
+
+func (mm MessageMention) TableName() string {
+	return "message_mention"
+}
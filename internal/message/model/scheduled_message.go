@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ScheduleStatusPending  = "pending"
+	ScheduleStatusSending  = "sending"
+	ScheduleStatusSent     = "sent"
+	ScheduleStatusCanceled = "canceled"
+	ScheduleStatusFailed   = "failed"
+)
+
+type ScheduledMessage struct {
+	ID         int64     `gorm:"id" json:"id"`
+	UserId     int64     `gorm:"user_id" json:"user_id"`
+	ToId       int64     `gorm:"to_id" json:"to_id"`
+	Kind       string    `gorm:"kind" json:"kind"`
+	Type       string    `gorm:"column:type" json:"type"`
+	Content    string    `gorm:"content" json:"content"`
+	Mentions   string    `gorm:"mentions" json:"mentions"`
+	MentionAll bool      `gorm:"mention_all" json:"mention_all"`
+	SendAt     time.Time `gorm:"send_at" json:"send_at"`
+	Status     string    `gorm:"status" json:"status"`
+	// 发送成功后的消息ID
+	MessageId int64  `gorm:"message_id" json:"message_id"`
+	Error     string `gorm:"error" json:"error"`
+	gorm.Model
+}
+
+func (sm ScheduledMessage) TableName() string {
+	return "scheduled_message"
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -10,6 +12,13 @@ type UserSession struct {
 	ToId   int64  `gorm:"to_id" json:"to_id"`
 	Kind   string `gorm:"kind" json:"kind"`
 	Seq    int64  `gorm:"seq" json:"seq"`
+	// 为 NULL 表示未设置
+	PinnedAt   *time.Time `gorm:"pinned_at" json:"pinned_at"`
+	MutedAt    *time.Time `gorm:"muted_at" json:"muted_at"`
+	ArchivedAt *time.Time `gorm:"archived_at" json:"archived_at"`
+	HiddenAt   *time.Time `gorm:"hidden_at" json:"hidden_at"`
+	// 用户清空聊天记录时的会话序号，之前的消息不再返回
+	ClearedSeq int64 `gorm:"cleared_seq" json:"cleared_seq"`
 	gorm.Model
 }
 
@@ -0,0 +1,162 @@
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"go-im/internal/pkg/mjson"
+	"html/template"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	FormatJSON = "jsonl"
+	FormatCSV  = "csv"
+	FormatHTML = "html"
+)
+
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// Record 导出的一条消息
+type Record struct {
+	Id       int64     `json:"id"`
+	Seq      int64     `json:"seq"`
+	FromId   int64     `json:"fromId"`
+	FromName string    `json:"fromName"`
+	Type     string    `json:"type"`
+	Content  string    `json:"content"`
+	SendTime time.Time `json:"sendTime"`
+	Revoked  bool      `json:"revoked"`
+}
+
+// Writer 逐条写出消息，Close 写入结尾但不关闭底层 io.Writer
+type Writer interface {
+	Write(r *Record) error
+	Close() error
+}
+
+// NewWriter title 只在 HTML 中使用
+func NewWriter(format string, w io.Writer, title string) (Writer, error) {
+	switch format {
+	case FormatJSON:
+		return &jsonWriter{w: w}, nil
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"id", "seq", "send_time", "from_id", "from_name", "type", "content", "revoked"}); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: cw}, nil
+	case FormatHTML:
+		if err := htmlTpl.ExecuteTemplate(w, "header", title); err != nil {
+			return nil, err
+		}
+		return &htmlWriter{w: w}, nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+// Ext 文件扩展名
+func Ext(format string) string {
+	if format == FormatJSON {
+		return ".jsonl"
+	}
+	return "." + format
+}
+
+// ContentType 下载时的 Content-Type
+func ContentType(format string) string {
+	switch format {
+	case FormatJSON:
+		return "application/x-ndjson; charset=utf-8"
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	}
+	return "application/octet-stream"
+}
+
+func ValidFormat(format string) bool {
+	return format == FormatJSON || format == FormatCSV || format == FormatHTML
+}
+
+type jsonWriter struct {
+	w io.Writer
+}
+
+func (j *jsonWriter) Write(r *Record) error {
+	b, err := mjson.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = j.w.Write(append(b, '\n'))
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	return nil
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Write(r *Record) error {
+	return c.w.Write([]string{
+		strconv.FormatInt(r.Id, 10),
+		strconv.FormatInt(r.Seq, 10),
+		r.SendTime.Format(time.RFC3339),
+		strconv.FormatInt(r.FromId, 10),
+		r.FromName,
+		r.Type,
+		r.Content,
+		strconv.FormatBool(r.Revoked),
+	})
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// html/template 会转义内容并过滤 javascript: 之类的图片地址
+var htmlTpl = template.Must(template.New("").Funcs(template.FuncMap{
+	"time": func(t time.Time) string { return t.Format("2006-01-02 15:04:05") },
+}).Parse(`{{define "header"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.}}</title>
+<style>
+body{font-family:sans-serif;max-width:800px;margin:0 auto;padding:16px;color:#222}
+.msg{padding:8px 0;border-bottom:1px solid #eee}
+.meta{color:#888;font-size:12px}
+.content{white-space:pre-wrap;word-break:break-all;margin-top:4px}
+.revoked{color:#aaa;font-style:italic}
+img{max-width:320px}
+</style>
+</head>
+<body>
+<h1>{{.}}</h1>
+{{end}}{{define "record"}}<div class="msg">
+<div class="meta"><span class="from">{{.FromName}}</span> <span class="time">{{time .SendTime}}</span></div>
+{{if .Revoked}}<div class="content revoked">消息已删除</div>
+{{else if eq .Type "image"}}<div class="content"><img src="{{.Content}}" alt="image" loading="lazy"></div>
+{{else}}<div class="content">{{.Content}}</div>
+{{end}}</div>
+{{end}}{{define "footer"}}</body>
+</html>
+{{end}}`))
+
+type htmlWriter struct {
+	w io.Writer
+}
+
+func (h *htmlWriter) Write(r *Record) error {
+	return htmlTpl.ExecuteTemplate(h.w, "record", r)
+}
+
+func (h *htmlWriter) Close() error {
+	return htmlTpl.ExecuteTemplate(h.w, "footer", nil)
+}
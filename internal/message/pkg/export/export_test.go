@@ -0,0 +1,85 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"go-im/internal/pkg/mjson"
+	"strings"
+	"testing"
+	"time"
+)
+
+var records = []*Record{
+	{Id: 1, Seq: 1, FromId: 1, FromName: "alice", Type: "text", Content: "hi, \"bob\"\n<b>bold</b>", SendTime: time.Unix(100, 0)},
+	{Id: 2, Seq: 2, FromId: 2, FromName: "bob", Type: "image", Content: "https://cdn.example.com/a.png", SendTime: time.Unix(200, 0)},
+	{Id: 3, Seq: 3, FromId: 2, FromName: "bob", Type: "image", Content: "javascript:alert(1)", SendTime: time.Unix(300, 0)},
+	{Id: 4, Seq: 4, FromId: 1, FromName: "alice", Type: "text", SendTime: time.Unix(400, 0), Revoked: true},
+}
+
+func write(t *testing.T, format string) string {
+	var buf bytes.Buffer
+	w, err := NewWriter(format, &buf, "alice & bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range records {
+		if err := w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestJSON(t *testing.T) {
+	lines := strings.Split(strings.TrimSuffix(write(t, FormatJSON), "\n"), "\n")
+	if len(lines) != len(records) {
+		t.Fatalf("expected %d lines, got %d", len(records), len(lines))
+	}
+	var r Record
+	if err := mjson.Unmarshal([]byte(lines[0]), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Content != records[0].Content || r.FromName != "alice" {
+		t.Fatalf("unexpected record %+v", r)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	rows, err := csv.NewReader(strings.NewReader(write(t, FormatCSV))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != len(records)+1 || rows[0][0] != "id" {
+		t.Fatalf("unexpected rows %v", rows)
+	}
+	if rows[1][6] != records[0].Content || rows[4][7] != "true" {
+		t.Fatalf("unexpected rows %v", rows)
+	}
+}
+
+func TestHTML(t *testing.T) {
+	out := write(t, FormatHTML)
+	for _, want := range []string{
+		"<title>alice &amp; bob</title>",
+		"&lt;b&gt;bold&lt;/b&gt;",
+		`<img src="https://cdn.example.com/a.png"`,
+		"消息已删除",
+		"</html>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+	if strings.Contains(out, "javascript:") {
+		t.Error("unsafe image url not filtered")
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	if _, err := NewWriter("xml", &bytes.Buffer{}, ""); err != ErrUnknownFormat {
+		t.Fatalf("expected ErrUnknownFormat, got %v", err)
+	}
+}
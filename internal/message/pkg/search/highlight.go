@@ -0,0 +1,79 @@
+package search
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	HighlightPre  = "<em>"
+	HighlightPost = "</em>"
+
+	// 第一个命中位置前保留的字数
+	snippetContext = 10
+	snippetLength  = 60
+)
+
+// Highlight 截取第一个命中附近的片段并标记所有命中的词，片段内容做 HTML 转义
+func Highlight(content string, terms []string) string {
+	runes := []rune(content)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	ranges := make([][2]int, 0)
+	for _, term := range terms {
+		t := []rune(term)
+		if len(t) == 0 {
+			continue
+		}
+		for i := 0; i+len(t) <= len(lower); i++ {
+			if slices.Equal(lower[i:i+len(t)], t) {
+				ranges = append(ranges, [2]int{i, i + len(t)})
+			}
+		}
+	}
+	slices.SortFunc(ranges, func(a, b [2]int) int {
+		return a[0] - b[0]
+	})
+	merged := make([][2]int, 0, len(ranges))
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r[0] <= merged[n-1][1] {
+			merged[n-1][1] = max(merged[n-1][1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	start := 0
+	if len(merged) > 0 {
+		start = max(0, merged[0][0]-snippetContext)
+	}
+	end := min(len(runes), start+snippetLength)
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("...")
+	}
+	pos := start
+	for _, r := range merged {
+		if r[1] <= start {
+			continue
+		}
+		if r[0] >= end {
+			break
+		}
+		from, to := max(r[0], start), min(r[1], end)
+		b.WriteString(html.EscapeString(string(runes[pos:from])))
+		b.WriteString(HighlightPre)
+		b.WriteString(html.EscapeString(string(runes[from:to])))
+		b.WriteString(HighlightPost)
+		pos = to
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("...")
+	}
+	return b.String()
+}
@@ -0,0 +1,164 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// MemoryIndex 进程内倒排索引，适合单实例部署，重启后需要重新灌入数据
+type MemoryIndex struct {
+	m        sync.RWMutex
+	docs     map[int64]*Document
+	postings map[string]map[int64]struct{}
+}
+
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		docs:     make(map[int64]*Document),
+		postings: make(map[string]map[int64]struct{}),
+	}
+}
+
+func (idx *MemoryIndex) Add(ctx context.Context, docs ...*Document) error {
+	idx.m.Lock()
+	defer idx.m.Unlock()
+	for _, doc := range docs {
+		if _, ok := idx.docs[doc.Id]; ok {
+			idx.remove(doc.Id)
+		}
+		idx.docs[doc.Id] = doc
+		for _, t := range Tokenize(doc.Content) {
+			p, ok := idx.postings[t]
+			if !ok {
+				p = make(map[int64]struct{})
+				idx.postings[t] = p
+			}
+			p[doc.Id] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func (idx *MemoryIndex) Delete(ctx context.Context, ids ...int64) error {
+	idx.m.Lock()
+	defer idx.m.Unlock()
+	for _, id := range ids {
+		idx.remove(id)
+	}
+	return nil
+}
+
+func (idx *MemoryIndex) remove(id int64) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	delete(idx.docs, id)
+	for _, t := range Tokenize(doc.Content) {
+		p := idx.postings[t]
+		delete(p, id)
+		if len(p) == 0 {
+			delete(idx.postings, t)
+		}
+	}
+}
+
+func (idx *MemoryIndex) Search(ctx context.Context, q *Query) ([]*Hit, error) {
+	terms := Terms(q.Keyword)
+	if len(terms) == 0 || len(q.Conversations) == 0 {
+		return nil, nil
+	}
+	scope := make(map[Conversation]struct{}, len(q.Conversations))
+	for _, c := range q.Conversations {
+		scope[c] = struct{}{}
+	}
+
+	idx.m.RLock()
+	lists := make([]map[int64]struct{}, 0)
+	for _, term := range terms {
+		for _, t := range queryTokens(term) {
+			p, ok := idx.postings[t]
+			if !ok {
+				idx.m.RUnlock()
+				return nil, nil
+			}
+			lists = append(lists, p)
+		}
+	}
+	// 从最短的倒排表开始求交集
+	slices.SortFunc(lists, func(a, b map[int64]struct{}) int {
+		return cmp.Compare(len(a), len(b))
+	})
+	matched := make([]*Document, 0)
+	for id := range lists[0] {
+		ok := true
+		for _, p := range lists[1:] {
+			if _, ok = p[id]; !ok {
+				break
+			}
+		}
+		if !ok {
+			continue
+		}
+		doc := idx.docs[id]
+		if match(doc, q, scope, terms) {
+			matched = append(matched, doc)
+		}
+	}
+	idx.m.RUnlock()
+
+	slices.SortFunc(matched, func(a, b *Document) int {
+		if c := cmp.Compare(b.SendTime, a.SendTime); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Id, a.Id)
+	})
+	if q.Offset >= len(matched) {
+		return nil, nil
+	}
+	matched = matched[q.Offset:]
+	if q.Limit > 0 && len(matched) > q.Limit {
+		matched = matched[:q.Limit]
+	}
+	hits := make([]*Hit, 0, len(matched))
+	for _, doc := range matched {
+		hits = append(hits, &Hit{
+			Doc:     doc,
+			Snippet: Highlight(doc.Content, terms),
+		})
+	}
+	return hits, nil
+}
+
+func match(doc *Document, q *Query, scope map[Conversation]struct{}, terms []string) bool {
+	c := ConversationOf(doc.Kind, doc.FromId, doc.ToId)
+	if _, ok := scope[c]; !ok {
+		return false
+	}
+	if doc.Seq <= q.ClearedSeq[c] {
+		return false
+	}
+	if q.FromId > 0 && doc.FromId != q.FromId {
+		return false
+	}
+	if slices.Contains(q.ExcludeTypes, doc.Type) {
+		return false
+	}
+	if q.StartTime > 0 && doc.SendTime < q.StartTime {
+		return false
+	}
+	if q.EndTime > 0 && doc.SendTime > q.EndTime {
+		return false
+	}
+	// 倒排表只保证词项都出现，还需确认整个词连续出现
+	content := strings.ToLower(doc.Content)
+	for _, term := range terms {
+		if !strings.Contains(content, term) {
+			return false
+		}
+	}
+	return true
+}
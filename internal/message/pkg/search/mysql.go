@@ -0,0 +1,123 @@
+package search
+
+import (
+	"context"
+	"go-im/internal/pkg/db"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type messageRow struct {
+	ID        int64
+	FromId    int64
+	ToId      int64
+	Seq       int64
+	Kind      string
+	Type      string
+	Content   string
+	CreatedAt time.Time
+	DeletedAt gorm.DeletedAt
+}
+
+func (messageRow) TableName() string {
+	return "message"
+}
+
+// MySQLIndex 直接查询 message 表，需要 content 上建 WITH PARSER ngram 的全文索引
+// 消息写入时由 MySQL 维护索引，Add/Delete 无需处理
+type MySQLIndex struct {
+	db *db.DB
+}
+
+func NewMySQLIndex(db *db.DB) *MySQLIndex {
+	return &MySQLIndex{db: db}
+}
+
+func (idx *MySQLIndex) Add(ctx context.Context, docs ...*Document) error {
+	return nil
+}
+
+func (idx *MySQLIndex) Delete(ctx context.Context, ids ...int64) error {
+	return nil
+}
+
+func (idx *MySQLIndex) Search(ctx context.Context, q *Query) ([]*Hit, error) {
+	terms := Terms(q.Keyword)
+	if len(terms) == 0 || len(q.Conversations) == 0 {
+		return nil, nil
+	}
+	// 布尔模式下每个词按短语必须命中
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		parts = append(parts, `+"`+strings.ReplaceAll(term, `"`, "")+`"`)
+	}
+	against := strings.Join(parts, " ")
+
+	scope := make([]string, 0)
+	args := make([]any, 0)
+	groups := make([]int64, 0)
+	for _, c := range q.Conversations {
+		cleared := q.ClearedSeq[c]
+		if c.Kind == "group" {
+			if cleared == 0 {
+				groups = append(groups, c.B)
+			} else {
+				scope = append(scope, "(kind='group' AND to_id=? AND seq>?)")
+				args = append(args, c.B, cleared)
+			}
+			continue
+		}
+		scope = append(scope, "(kind='single' AND ((from_id=? AND to_id=?) OR (from_id=? AND to_id=?)) AND seq>?)")
+		args = append(args, c.A, c.B, c.B, c.A, cleared)
+	}
+	if len(groups) > 0 {
+		scope = append(scope, "(kind='group' AND to_id IN ?)")
+		args = append(args, groups)
+	}
+	scopeExpr := "(" + strings.Join(scope, " OR ") + ")"
+
+	var rows []*messageRow
+	err := idx.db.Wrap(ctx, "SearchMessage", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where("MATCH(content) AGAINST(? IN BOOLEAN MODE)", against).Where(scopeExpr, args...).Where("revoked_at IS NULL").
+			Where("(expire_at IS NULL OR expire_at>?)", time.Now())
+		if q.FromId > 0 {
+			tx = tx.Where("from_id=?", q.FromId)
+		}
+		if len(q.ExcludeTypes) > 0 {
+			tx = tx.Where("type NOT IN ?", q.ExcludeTypes)
+		}
+		if q.StartTime > 0 {
+			tx = tx.Where("created_at>=?", time.UnixMilli(q.StartTime))
+		}
+		if q.EndTime > 0 {
+			tx = tx.Where("created_at<=?", time.UnixMilli(q.EndTime))
+		}
+		if q.Limit > 0 {
+			tx = tx.Limit(q.Limit)
+		}
+		return tx.Order("created_at DESC, id DESC").Offset(q.Offset).Find(&rows)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "SearchMessage")
+	}
+	hits := make([]*Hit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, &Hit{
+			Doc: &Document{
+				Id:       row.ID,
+				Kind:     row.Kind,
+				FromId:   row.FromId,
+				ToId:     row.ToId,
+				Seq:      row.Seq,
+				Type:     row.Type,
+				Content:  row.Content,
+				SendTime: row.CreatedAt.UnixMilli(),
+			},
+			Snippet: Highlight(row.Content, terms),
+		})
+	}
+	return hits, nil
+}
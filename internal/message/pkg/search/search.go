@@ -0,0 +1,82 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"go-im/internal/pkg/db"
+)
+
+const (
+	BackendMemory = "memory"
+	BackendMySQL  = "mysql"
+)
+
+type Config struct {
+	// memory: 进程内倒排索引，只适用于单副本部署，多副本时各副本只索引自己写入的消息；
+	// mysql: 依赖 message.content 上的 ngram 全文索引
+	Backend string `yaml:"backend"`
+}
+
+type Document struct {
+	Id       int64
+	Kind     string
+	FromId   int64
+	ToId     int64
+	Seq      int64
+	Type     string
+	Content  string
+	SendTime int64
+}
+
+// Conversation 单聊按 (较小ID, 较大ID) 归一，群聊为 (0, 群ID)
+type Conversation struct {
+	Kind string
+	A    int64
+	B    int64
+}
+
+func ConversationOf(kind string, fromId, toId int64) Conversation {
+	if kind == "group" {
+		return Conversation{Kind: kind, B: toId}
+	}
+	return Conversation{Kind: kind, A: min(fromId, toId), B: max(fromId, toId)}
+}
+
+type Query struct {
+	Keyword string
+	// 可见范围，为空时不返回任何结果
+	Conversations []Conversation
+	// 各会话被用户清空的序号，不返回该序号及之前的消息
+	ClearedSeq map[Conversation]int64
+	FromId     int64
+	// 不返回这些类型的消息，内容不是可读文本时使用
+	ExcludeTypes []string
+	// 毫秒时间戳，0 表示不限
+	StartTime int64
+	EndTime   int64
+	Offset    int
+	Limit     int
+}
+
+type Hit struct {
+	Doc     *Document
+	Snippet string
+}
+
+// Index 消息检索后端，结果按发送时间倒序
+type Index interface {
+	Add(ctx context.Context, docs ...*Document) error
+	Delete(ctx context.Context, ids ...int64) error
+	Search(ctx context.Context, q *Query) ([]*Hit, error)
+}
+
+func NewIndex(cfg Config, db *db.DB) (Index, error) {
+	switch cfg.Backend {
+	case BackendMemory:
+		return NewMemoryIndex(), nil
+	case BackendMySQL, "":
+		return NewMySQLIndex(db), nil
+	default:
+		return nil, fmt.Errorf("unsupported search backend: %s", cfg.Backend)
+	}
+}
@@ -0,0 +1,78 @@
+package search
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize("Hello 你好世界, go1.24")
+	for _, want := range []string{"hello", "你", "你好", "好世", "世界", "界", "go1", "24"} {
+		if !slices.Contains(tokens, want) {
+			t.Errorf("missing token %q in %v", want, tokens)
+		}
+	}
+}
+
+func TestMemoryIndex(t *testing.T) {
+	ctx := context.Background()
+	idx := NewMemoryIndex()
+	_ = idx.Add(ctx,
+		&Document{Id: 1, Kind: "single", FromId: 1, ToId: 2, Seq: 1, Content: "明天一起吃饭吗", SendTime: 100},
+		&Document{Id: 2, Kind: "single", FromId: 2, ToId: 1, Seq: 2, Content: "好的，明天中午吃饭", SendTime: 200},
+		&Document{Id: 3, Kind: "single", FromId: 3, ToId: 4, Seq: 1, Content: "明天吃饭", SendTime: 300},
+		&Document{Id: 4, Kind: "group", FromId: 3, ToId: 10, Seq: 1, Content: "Meeting 明天 10 点", SendTime: 400},
+	)
+	scope := []Conversation{ConversationOf("single", 1, 2), ConversationOf("group", 1, 10)}
+
+	hits, _ := idx.Search(ctx, &Query{Keyword: "吃饭", Conversations: scope})
+	if len(hits) != 2 || hits[0].Doc.Id != 2 || hits[1].Doc.Id != 1 {
+		t.Fatalf("unexpected hits: %v", hits)
+	}
+	hits, _ = idx.Search(ctx, &Query{Keyword: "明天 meeting", Conversations: scope})
+	if len(hits) != 1 || hits[0].Doc.Id != 4 {
+		t.Fatalf("unexpected hits: %v", hits)
+	}
+	hits, _ = idx.Search(ctx, &Query{Keyword: "明天", Conversations: scope, FromId: 2})
+	if len(hits) != 1 || hits[0].Doc.Id != 2 {
+		t.Fatalf("unexpected hits: %v", hits)
+	}
+	hits, _ = idx.Search(ctx, &Query{Keyword: "明天", Conversations: scope, StartTime: 150, EndTime: 350})
+	if len(hits) != 1 || hits[0].Doc.Id != 2 {
+		t.Fatalf("unexpected hits: %v", hits)
+	}
+	// 双字都出现但不连续
+	hits, _ = idx.Search(ctx, &Query{Keyword: "天吃", Conversations: scope})
+	if len(hits) != 0 {
+		t.Fatalf("unexpected hits: %v", hits)
+	}
+
+	hits, _ = idx.Search(ctx, &Query{Keyword: "吃饭", Conversations: scope, ClearedSeq: map[Conversation]int64{scope[0]: 1}})
+	if len(hits) != 1 || hits[0].Doc.Id != 2 {
+		t.Fatalf("unexpected hits: %v", hits)
+	}
+
+	_ = idx.Delete(ctx, 2)
+	hits, _ = idx.Search(ctx, &Query{Keyword: "吃饭", Conversations: scope})
+	if len(hits) != 1 || hits[0].Doc.Id != 1 {
+		t.Fatalf("unexpected hits: %v", hits)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	cases := []struct {
+		content string
+		terms   []string
+		want    string
+	}{
+		{"明天一起吃饭吗", []string{"吃饭"}, "明天一起<em>吃饭</em>吗"},
+		{"Hello <b>World</b>", []string{"world"}, "Hello &lt;b&gt;<em>World</em>&lt;/b&gt;"},
+		{"这是一段很长很长的前缀文字用来测试截断效果，然后才出现关键词", []string{"关键词"}, "...截断效果，然后才出现<em>关键词</em>"},
+	}
+	for _, c := range cases {
+		if got := Highlight(c.content, c.terms); got != c.want {
+			t.Errorf("Highlight(%q) = %q, want %q", c.content, got, c.want)
+		}
+	}
+}
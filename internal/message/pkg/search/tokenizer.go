@@ -0,0 +1,89 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 中日韩文字没有空格分词，按单字和相邻双字建索引；其他文字按字母数字连续串切词
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
+
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Tokenize 生成建索引用的词项，已去重
+func Tokenize(text string) []string {
+	seen := make(map[string]struct{})
+	tokens := make([]string, 0)
+	add := func(t string) {
+		if _, ok := seen[t]; ok {
+			return
+		}
+		seen[t] = struct{}{}
+		tokens = append(tokens, t)
+	}
+	walk(text, func(word []rune, cjk bool) {
+		if !cjk {
+			add(string(word))
+			return
+		}
+		for i := range word {
+			add(string(word[i]))
+			if i+1 < len(word) {
+				add(string(word[i : i+2]))
+			}
+		}
+	})
+	return tokens
+}
+
+// queryTokens 生成检索用的词项，中文只用双字，单个字时才用单字
+func queryTokens(text string) []string {
+	tokens := make([]string, 0)
+	walk(text, func(word []rune, cjk bool) {
+		if !cjk || len(word) == 1 {
+			tokens = append(tokens, string(word))
+			return
+		}
+		for i := 0; i+1 < len(word); i++ {
+			tokens = append(tokens, string(word[i:i+2]))
+		}
+	})
+	return tokens
+}
+
+// Terms 把关键词按空白拆成需要同时命中的词
+func Terms(keyword string) []string {
+	fields := strings.Fields(strings.ToLower(keyword))
+	terms := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if len(queryTokens(f)) > 0 {
+			terms = append(terms, f)
+		}
+	}
+	return terms
+}
+
+func walk(text string, fn func(word []rune, cjk bool)) {
+	runes := []rune(strings.ToLower(text))
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if !isWord(r) {
+			i++
+			continue
+		}
+		cjk := isCJK(r)
+		j := i + 1
+		for j < len(runes) && isWord(runes[j]) && isCJK(runes[j]) == cjk {
+			j++
+		}
+		fn(runes[i:j], cjk)
+		i = j
+	}
+}
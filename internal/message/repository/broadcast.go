@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/db"
+	"go-im/internal/pkg/mtrace"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BroadcastRepository struct {
+	db *db.DB
+}
+
+func NewBroadcastRepository(db *db.DB) *BroadcastRepository {
+	return &BroadcastRepository{db}
+}
+
+func (b *BroadcastRepository) Create(ctx context.Context, data *model.Broadcast) (int64, error) {
+	err := b.db.Wrap(ctx, "Create", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(data)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Create")
+	}
+	return data.ID, nil
+}
+
+func (b *BroadcastRepository) FindOne(ctx context.Context, id int64) (*model.Broadcast, error) {
+	var resp *model.Broadcast
+	err := b.db.Wrap(ctx, "FindOne", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "id=?", id)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindOne")
+	}
+	return resp, nil
+}
+
+func (b *BroadcastRepository) ListByIds(ctx context.Context, ids []int64) ([]*model.Broadcast, error) {
+	var resp []*model.Broadcast
+	err := b.db.Wrap(ctx, "ListByIds", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id IN ?", ids).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListByIds")
+	}
+	return resp, nil
+}
+
+// ListUnfinished 返回还没投递完的公告，先创建的先投递
+func (b *BroadcastRepository) ListUnfinished(ctx context.Context, limit int) ([]*model.Broadcast, error) {
+	var resp []*model.Broadcast
+	err := b.db.Wrap(ctx, "ListUnfinished", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("status<>?", model.BroadcastStatusSent).Order("id ASC").Limit(limit).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListUnfinished")
+	}
+	return resp, nil
+}
+
+// Fanout 写入一批回执并推进游标，游标不是 lastUserId 说明已被其他副本处理，返回 false
+func (b *BroadcastRepository) Fanout(ctx context.Context, id int64, lastUserId int64, userIds []int64, delivered map[int64]bool, done bool) (bool, error) {
+	_, span := mtrace.StartSpan(ctx, "Fanout", trace.WithSpanKind(trace.SpanKindInternal))
+	defer mtrace.EndSpan(span)
+	sql := make([]string, 0)
+	defer func() {
+		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
+	}()
+	status := model.BroadcastStatusSending
+	if done {
+		status = model.BroadcastStatusSent
+	}
+	values := map[string]any{
+		"status":          status,
+		"total_count":     gorm.Expr("total_count+?", len(userIds)),
+		"delivered_count": gorm.Expr("delivered_count+?", len(delivered)),
+	}
+	if len(userIds) > 0 {
+		values["last_user_id"] = userIds[len(userIds)-1]
+	}
+	var affected int64
+	err := b.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		stmt := tx.Model(&model.Broadcast{}).Where("id=? AND last_user_id=?", id, lastUserId).Updates(values)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.Broadcast{}).Where("id=? AND last_user_id=?", id, lastUserId).Updates(values)
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		affected = stmt.RowsAffected
+		if affected == 0 || len(userIds) == 0 {
+			return nil
+		}
+		now := time.Now()
+		receipts := make([]*model.BroadcastReceipt, 0, len(userIds))
+		for _, userId := range userIds {
+			receipt := &model.BroadcastReceipt{
+				BroadcastId: id,
+				UserId:      userId,
+			}
+			if delivered[userId] {
+				receipt.DeliveredAt = &now
+			}
+			receipts = append(receipts, receipt)
+		}
+		stmt = tx.Create(&receipts)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Create(&receipts)
+		}))
+		return stmt.Error
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "Fanout")
+	}
+	return affected > 0, nil
+}
+
+// ListReceipt 按公告ID倒序返回用户收到的公告回执
+func (b *BroadcastRepository) ListReceipt(ctx context.Context, userId int64, beforeId int64, limit int) ([]*model.BroadcastReceipt, error) {
+	var resp []*model.BroadcastReceipt
+	err := b.db.Wrap(ctx, "ListReceipt", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where("user_id=?", userId)
+		if beforeId > 0 {
+			tx = tx.Where("broadcast_id<?", beforeId)
+		}
+		return tx.Order("broadcast_id DESC").Limit(limit).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListReceipt")
+	}
+	return resp, nil
+}
+
+func (b *BroadcastRepository) CountUnread(ctx context.Context, userId int64) (int64, error) {
+	var count int64
+	err := b.db.Wrap(ctx, "CountUnread", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.BroadcastReceipt{}).Where("user_id=? AND read_at IS NULL", userId).Count(&count)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "CountUnread")
+	}
+	return count, nil
+}
+
+// HasUndelivered 用户是否还有未送达的回执，不加锁，只用来判断要不要调用 MarkDelivered
+func (b *BroadcastRepository) HasUndelivered(ctx context.Context, userId int64) (bool, error) {
+	var ids []int64
+	err := b.db.Wrap(ctx, "HasUndelivered", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.BroadcastReceipt{}).Where("user_id=? AND delivered_at IS NULL", userId).
+			Limit(1).Pluck("id", &ids)
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "HasUndelivered")
+	}
+	return len(ids) > 0, nil
+}
+
+// MarkDelivered 把用户所有未送达的回执记为送达，并累加对应公告的送达数
+func (b *BroadcastRepository) MarkDelivered(ctx context.Context, userId int64) error {
+	_, span := mtrace.StartSpan(ctx, "MarkDelivered", trace.WithSpanKind(trace.SpanKindInternal))
+	defer mtrace.EndSpan(span)
+	sql := make([]string, 0)
+	defer func() {
+		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
+	}()
+	err := b.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []int64
+		stmt := tx.Model(&model.BroadcastReceipt{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id=? AND delivered_at IS NULL", userId).Pluck("broadcast_id", &ids)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.BroadcastReceipt{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("user_id=? AND delivered_at IS NULL", userId).Pluck("broadcast_id", &ids)
+		}))
+		if stmt.Error != nil || len(ids) == 0 {
+			return stmt.Error
+		}
+		now := time.Now()
+		stmt = tx.Model(&model.BroadcastReceipt{}).Where("user_id=? AND broadcast_id IN ?", userId, ids).
+			Update("delivered_at", now)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.BroadcastReceipt{}).Where("user_id=? AND broadcast_id IN ?", userId, ids).
+				Update("delivered_at", now)
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		stmt = tx.Model(&model.Broadcast{}).Where("id IN ?", ids).
+			Update("delivered_count", gorm.Expr("delivered_count+1"))
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.Broadcast{}).Where("id IN ?", ids).
+				Update("delivered_count", gorm.Expr("delivered_count+1"))
+		}))
+		return stmt.Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "MarkDelivered")
+	}
+	return nil
+}
+
+// MarkRead 标记已读并累加公告已读数，未送达的同时记为送达，用户不在目标人群中返回 gorm.ErrRecordNotFound
+func (b *BroadcastRepository) MarkRead(ctx context.Context, userId int64, id int64) error {
+	_, span := mtrace.StartSpan(ctx, "MarkRead", trace.WithSpanKind(trace.SpanKindInternal))
+	defer mtrace.EndSpan(span)
+	sql := make([]string, 0)
+	defer func() {
+		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
+	}()
+	err := b.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var receipt *model.BroadcastReceipt
+		stmt := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&receipt, "broadcast_id=? AND user_id=?", id, userId)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				First(&receipt, "broadcast_id=? AND user_id=?", id, userId)
+		}))
+		if stmt.Error != nil || receipt.ReadAt != nil {
+			return stmt.Error
+		}
+		now := time.Now()
+		values := map[string]any{"read_at": now}
+		counts := map[string]any{"read_count": gorm.Expr("read_count+1")}
+		if receipt.DeliveredAt == nil {
+			values["delivered_at"] = now
+			counts["delivered_count"] = gorm.Expr("delivered_count+1")
+		}
+		stmt = tx.Model(&model.BroadcastReceipt{}).Where("id=?", receipt.ID).Updates(values)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.BroadcastReceipt{}).Where("id=?", receipt.ID).Updates(values)
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		stmt = tx.Model(&model.Broadcast{}).Where("id=?", id).Updates(counts)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.Broadcast{}).Where("id=?", id).Updates(counts)
+		}))
+		return stmt.Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "MarkRead")
+	}
+	return nil
+}
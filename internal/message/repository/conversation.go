@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/db"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ConversationRepository struct {
+	db *db.DB
+}
+
+func NewConversationRepository(db *db.DB) *ConversationRepository {
+	return &ConversationRepository{db}
+}
+
+func (c *ConversationRepository) FindByKey(ctx context.Context, kind string, fromId int64, toId int64) (*model.Conversation, error) {
+	a, b := model.ConversationKey(kind, fromId, toId)
+	var resp *model.Conversation
+	err := c.db.Wrap(ctx, "FindByKey", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "kind=? AND from_id=? AND to_id=?", kind, a, b)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByKey")
+	}
+	return resp, nil
+}
+
+// ListByKeys 批量查询会话计数器，pairs 为已按 model.ConversationKey 归一的单聊双方
+func (c *ConversationRepository) ListByKeys(ctx context.Context, groupIds []int64, pairs [][]int64) ([]*model.Conversation, error) {
+	resp := make([]*model.Conversation, 0, len(groupIds)+len(pairs))
+	if len(groupIds) > 0 {
+		var list []*model.Conversation
+		err := c.db.Wrap(ctx, "ListByKeys", func(tx *gorm.DB) *gorm.DB {
+			return tx.Find(&list, "kind='group' AND from_id=0 AND to_id IN ?", groupIds)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "ListByKeys")
+		}
+		resp = append(resp, list...)
+	}
+	if len(pairs) > 0 {
+		var list []*model.Conversation
+		err := c.db.Wrap(ctx, "ListByKeys", func(tx *gorm.DB) *gorm.DB {
+			return tx.Find(&list, "kind='single' AND (from_id, to_id) IN ?", pairs)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "ListByKeys")
+		}
+		resp = append(resp, list...)
+	}
+	return resp, nil
+}
+
+// UpdateTtl 修改阅后即焚设置
+func (c *ConversationRepository) UpdateTtl(ctx context.Context, kind string, fromId int64, toId int64, ttl int64, mode string) error {
+	return c.updateSettings(ctx, "UpdateTtl", kind, fromId, toId, map[string]any{"ttl": ttl, "ttl_mode": mode}, func(conv *model.Conversation) {
+		conv.Ttl, conv.TtlMode = ttl, mode
+	})
+}
+
+// UpdateEncrypted 开启或关闭单聊端到端加密
+func (c *ConversationRepository) UpdateEncrypted(ctx context.Context, fromId int64, toId int64, encrypted bool) error {
+	return c.updateSettings(ctx, "UpdateEncrypted", "single", fromId, toId, map[string]any{"encrypted": encrypted}, func(conv *model.Conversation) {
+		conv.Encrypted = encrypted
+	})
+}
+
+// updateSettings 修改会话设置，计数器不存在时以历史消息的最大序号初始化，set 把设置写入新建的记录
+func (c *ConversationRepository) updateSettings(ctx context.Context, name string, kind string, fromId int64, toId int64, values map[string]any, set func(conv *model.Conversation)) error {
+	a, b := model.ConversationKey(kind, fromId, toId)
+	var affected int64
+	err := c.db.Wrap(ctx, name, func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.Conversation{}).Where("kind=? AND from_id=? AND to_id=?", kind, a, b).
+			Updates(values)
+		affected = tx.RowsAffected
+		return tx
+	})
+	if err != nil {
+		return errors.Wrap(err, name)
+	}
+	if affected > 0 {
+		return nil
+	}
+	var maxSeq int64
+	err = c.db.Wrap(ctx, name, func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.Message{}).Select("COALESCE(MAX(seq), 0)")
+		if kind == "group" {
+			tx = tx.Where("kind='group' AND to_id=?", b)
+		} else {
+			tx = tx.Where("kind='single' AND ((from_id=? AND to_id=?) OR (from_id=? AND to_id=?))", a, b, b, a)
+		}
+		return tx.Scan(&maxSeq)
+	})
+	if err != nil {
+		return errors.Wrap(err, name)
+	}
+	conv := &model.Conversation{
+		Kind:   kind,
+		FromId: a,
+		ToId:   b,
+		Seq:    maxSeq,
+	}
+	set(conv)
+	// 设置未变化时 UPDATE 影响行数同样为 0，这里冲突时再更新一次
+	err = c.db.Wrap(ctx, name, func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "kind"}, {Name: "from_id"}, {Name: "to_id"}},
+			DoUpdates: clause.Assignments(values),
+		}).Create(conv)
+	})
+	if err != nil {
+		return errors.Wrap(err, name)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/db"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ExportJobRepository struct {
+	db *db.DB
+}
+
+func NewExportJobRepository(db *db.DB) *ExportJobRepository {
+	return &ExportJobRepository{db}
+}
+
+func (e *ExportJobRepository) Create(ctx context.Context, data *model.ExportJob) (int64, error) {
+	err := e.db.Wrap(ctx, "Create", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(data)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Create")
+	}
+	return data.ID, nil
+}
+
+func (e *ExportJobRepository) FindOne(ctx context.Context, id int64) (*model.ExportJob, error) {
+	var resp *model.ExportJob
+	err := e.db.Wrap(ctx, "FindOne", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "id=?", id)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindOne")
+	}
+	return resp, nil
+}
+
+func (e *ExportJobRepository) ListPending(ctx context.Context, limit int) ([]*model.ExportJob, error) {
+	var resp []*model.ExportJob
+	err := e.db.Wrap(ctx, "ListPending", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("status=?", model.ExportStatusPending).Order("id ASC").Limit(limit).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListPending")
+	}
+	return resp, nil
+}
+
+// Claim 把待处理任务标记为处理中，返回是否抢到
+func (e *ExportJobRepository) Claim(ctx context.Context, id int64) (bool, error) {
+	var affected int64
+	err := e.db.Wrap(ctx, "Claim", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.ExportJob{}).
+			Where("id=? AND status=?", id, model.ExportStatusPending).
+			Update("status", model.ExportStatusRunning)
+		affected = tx.RowsAffected
+		return tx
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "Claim")
+	}
+	return affected > 0, nil
+}
+
+// Progress 更新已导出条数，同时刷新 updated_at 表明任务仍在进行
+func (e *ExportJobRepository) Progress(ctx context.Context, id int64, count int64) error {
+	err := e.db.Wrap(ctx, "Progress", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.ExportJob{}).Where("id=? AND status=?", id, model.ExportStatusRunning).
+			Update("message_count", count)
+	})
+	if err != nil {
+		return errors.Wrap(err, "Progress")
+	}
+	return nil
+}
+
+func (e *ExportJobRepository) Finish(ctx context.Context, id int64, status string, count int64, size int64, errMsg string) error {
+	err := e.db.Wrap(ctx, "Finish", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.ExportJob{}).Where("id=?", id).Updates(map[string]any{
+			"status":        status,
+			"message_count": count,
+			"file_size":     size,
+			"error":         errMsg,
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "Finish")
+	}
+	return nil
+}
+
+// ResetStuck 处理中途进程退出的任务重新放回待处理，重新生成前会清掉已写入的块
+func (e *ExportJobRepository) ResetStuck(ctx context.Context, before time.Time) error {
+	err := e.db.Wrap(ctx, "ResetStuck", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.ExportJob{}).
+			Where("status=? AND updated_at<?", model.ExportStatusRunning, before).
+			Update("status", model.ExportStatusPending)
+	})
+	if err != nil {
+		return errors.Wrap(err, "ResetStuck")
+	}
+	return nil
+}
+
+// ListExpired 完成时间早于 before 的任务，文件块需要清理
+func (e *ExportJobRepository) ListExpired(ctx context.Context, before time.Time, limit int) ([]*model.ExportJob, error) {
+	var resp []*model.ExportJob
+	err := e.db.Wrap(ctx, "ListExpired", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("status=? AND updated_at<?", model.ExportStatusDone, before).Order("id ASC").Limit(limit).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListExpired")
+	}
+	return resp, nil
+}
+
+func (e *ExportJobRepository) MarkExpired(ctx context.Context, ids []int64) error {
+	err := e.db.Wrap(ctx, "MarkExpired", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.ExportJob{}).Where("id IN ? AND status=?", ids, model.ExportStatusDone).
+			Update("status", model.ExportStatusExpired)
+	})
+	if err != nil {
+		return errors.Wrap(err, "MarkExpired")
+	}
+	return nil
+}
+
+func (e *ExportJobRepository) AddChunk(ctx context.Context, data *model.ExportChunk) error {
+	err := e.db.Wrap(ctx, "AddChunk", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(data)
+	})
+	if err != nil {
+		return errors.Wrap(err, "AddChunk")
+	}
+	return nil
+}
+
+// ListChunk 按序号分页读取文件块
+func (e *ExportJobRepository) ListChunk(ctx context.Context, jobId int64, afterIdx int, limit int) ([]*model.ExportChunk, error) {
+	var resp []*model.ExportChunk
+	err := e.db.Wrap(ctx, "ListChunk", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("job_id=? AND idx>?", jobId, afterIdx).Order("idx ASC").Limit(limit).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListChunk")
+	}
+	return resp, nil
+}
+
+// DeleteChunks 物理删除文件块，软删除不会释放空间
+func (e *ExportJobRepository) DeleteChunks(ctx context.Context, jobIds []int64) error {
+	err := e.db.Wrap(ctx, "DeleteChunks", func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Where("job_id IN ?", jobIds).Delete(&model.ExportChunk{})
+	})
+	if err != nil {
+		return errors.Wrap(err, "DeleteChunks")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/db"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ForwardBundleRepository struct {
+	db *db.DB
+}
+
+func NewForwardBundleRepository(db *db.DB) *ForwardBundleRepository {
+	return &ForwardBundleRepository{db}
+}
+
+func (f *ForwardBundleRepository) Create(ctx context.Context, bundle *model.ForwardBundle) (int64, error) {
+	err := f.db.Wrap(ctx, "Create", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(bundle)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Create")
+	}
+	return bundle.ID, nil
+}
+
+func (f *ForwardBundleRepository) FindOne(ctx context.Context, id int64) (*model.ForwardBundle, error) {
+	var resp *model.ForwardBundle
+	err := f.db.Wrap(ctx, "FindOne", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "id=?", id)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindOne")
+	}
+	return resp, nil
+}
@@ -31,6 +31,17 @@ func (g *GroupRepository) FindOne(ctx context.Context, id int64) (*model.Group,
 	return resp, nil
 }
 
+func (g *GroupRepository) ListByIds(ctx context.Context, ids []int64) ([]*model.Group, error) {
+	var resp []*model.Group
+	err := g.db.Wrap(ctx, "ListByIds", func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&resp, "id IN ?", ids)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListByIds")
+	}
+	return resp, nil
+}
+
 func (g *GroupRepository) FindOneByGroupNo(ctx context.Context, groupNo int64) (*model.Group, error) {
 	var resp *model.Group
 	err := g.db.Wrap(ctx, "FindOneByGroupNo", func(tx *gorm.DB) *gorm.DB {
@@ -70,7 +81,7 @@ func (g *GroupRepository) CreateGroup(ctx context.Context, group *model.Group) (
 	defer func() {
 		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
 	}()
-	err := g.db.Transaction(func(tx *gorm.DB) error {
+	err := g.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
 		stmt := tx.Create(&group)
 		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
 			return tx.Create(&group)
@@ -138,7 +149,7 @@ func (g *GroupRepository) DismissGroup(ctx context.Context, id int64) error {
 	defer func() {
 		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
 	}()
-	err := g.db.Transaction(func(tx *gorm.DB) error {
+	err := g.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
 		stmt := tx.Delete(&model.GroupMember{}, "group_id=?", id)
 		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
 			return tx.Delete(&model.GroupMember{}, "group_id=?", id)
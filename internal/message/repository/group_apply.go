@@ -77,14 +77,14 @@ func (g *GroupApplyRepository) HandleApply(ctx context.Context, applyId int64, s
 		}()
 
 		var groupApply *model.GroupApply
-		stmt := g.db.First(&groupApply, "id=?", applyId)
+		stmt := g.db.Conn(ctx).First(&groupApply, "id=?", applyId)
 		sql = append(sql, g.db.ToSQL(func(tx *gorm.DB) *gorm.DB {
 			return tx.First(&groupApply, "id=?", applyId)
 		}))
 		if stmt.Error != nil {
 			return stmt.Error
 		}
-		err := g.db.Transaction(func(tx *gorm.DB) error {
+		err := g.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
 			stmt := tx.Model(&model.GroupApply{}).Where("id=?", applyId).Update("status", status)
 			sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
 				return tx.Model(&model.GroupApply{}).Where("id=?", applyId).Update("status", status)
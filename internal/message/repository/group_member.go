@@ -12,6 +12,11 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	GroupMemberRoleMember = "member"
+	GroupMemberRoleAdmin  = "admin"
+)
+
 type GroupMemberRepository struct {
 	db *db.DB
 }
@@ -31,6 +36,26 @@ func (g *GroupMemberRepository) MemberCount(ctx context.Context, groupId int64)
 	return count, nil
 }
 
+// MemberCounts 批量统计群成员数
+func (g *GroupMemberRepository) MemberCounts(ctx context.Context, groupIds []int64) (map[int64]int64, error) {
+	var rows []struct {
+		GroupId int64
+		Count   int64
+	}
+	err := g.db.Wrap(ctx, "MemberCounts", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.GroupMember{}).Select("group_id, COUNT(*) AS count").
+			Where("group_id IN ?", groupIds).Group("group_id").Scan(&rows)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "MemberCounts")
+	}
+	resp := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		resp[row.GroupId] = row.Count
+	}
+	return resp, nil
+}
+
 func (g *GroupMemberRepository) InviteMember(ctx context.Context, groupId int64, userId []int64) error {
 	_, span := mtrace.StartSpan(ctx, "InviteMember", trace.WithSpanKind(trace.SpanKindInternal))
 	defer mtrace.EndSpan(span)
@@ -38,7 +63,7 @@ func (g *GroupMemberRepository) InviteMember(ctx context.Context, groupId int64,
 	defer func() {
 		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
 	}()
-	err := g.db.Transaction(func(tx *gorm.DB) error {
+	err := g.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
 		sessions := make([]*model.UserSession, 0, len(userId))
 		for _, id := range userId {
 			sessions = append(sessions, &model.UserSession{
@@ -95,6 +120,17 @@ func (g *GroupMemberRepository) IsMember(ctx context.Context, groupId int64, use
 	return true, nil
 }
 
+func (g *GroupMemberRepository) GetMember(ctx context.Context, groupId int64, userId int64) (*model.GroupMember, error) {
+	var resp *model.GroupMember
+	err := g.db.Wrap(ctx, "GetMember", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "group_id=? AND user_id=?", groupId, userId)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetMember")
+	}
+	return resp, nil
+}
+
 func (g *GroupMemberRepository) RemvoeMember(ctx context.Context, groupId int64, userId int64) error {
 	_, span := mtrace.StartSpan(ctx, "RemvoeMember", trace.WithSpanKind(trace.SpanKindInternal))
 	defer mtrace.EndSpan(span)
@@ -102,7 +138,7 @@ func (g *GroupMemberRepository) RemvoeMember(ctx context.Context, groupId int64,
 	defer func() {
 		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
 	}()
-	err := g.db.Transaction(func(tx *gorm.DB) error {
+	err := g.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
 		stmt := tx.Delete(&model.GroupMember{}, "group_id=? AND user_id=?", groupId, userId)
 		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
 			return tx.Delete(&model.GroupMember{}, "group_id=? AND user_id=?", groupId, userId)
@@ -150,3 +186,17 @@ func (g *GroupMemberRepository) ListMember(ctx context.Context, groupId int64) (
 	}
 	return resp, nil
 }
+
+// ListUserIdsInGroups 按用户ID升序返回多个群的成员，同一用户只出现一次
+func (g *GroupMemberRepository) ListUserIdsInGroups(ctx context.Context, groupIds []int64, afterId int64, limit int) ([]int64, error) {
+	var ids []int64
+	err := g.db.Wrap(ctx, "ListUserIdsInGroups", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.GroupMember{}).Distinct("user_id").
+			Where("group_id IN ? AND user_id>?", groupIds, afterId).
+			Order("user_id ASC").Limit(limit).Pluck("user_id", &ids)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListUserIdsInGroups")
+	}
+	return ids, nil
+}
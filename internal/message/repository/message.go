@@ -1,14 +1,27 @@
 package repository
 
 import (
+	"cmp"
 	"context"
 	"go-im/internal/message/model"
 	"go-im/internal/pkg/db"
+	"go-im/internal/pkg/mtrace"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// 排除用户仅对自己删除的消息
+const notDeletedFor = "id NOT IN (SELECT message_id FROM message_deletion WHERE user_id=? AND deleted_at IS NULL)"
+
+// 排除已过期但还未被清理的阅后即焚消息
+const notExpired = "(expire_at IS NULL OR expire_at>?)"
+
 type MessageRepository struct {
 	db *db.DB
 }
@@ -17,9 +30,98 @@ func NewMessageRepository(db *db.DB) *MessageRepository {
 	return &MessageRepository{db}
 }
 
-func (m *MessageRepository) Insert(ctx context.Context, data *model.Message) (int64, error) {
-	err := m.db.Wrap(ctx, "Insert", func(tx *gorm.DB) *gorm.DB {
-		return tx.Create(&data)
+// Insert 在同一事务中分配会话序号并落库，发送方的已读序号同步推进
+func (m *MessageRepository) Insert(ctx context.Context, data *model.Message, mentions []int64, mentionAll bool) (int64, error) {
+	_, span := mtrace.StartSpan(ctx, "Insert", trace.WithSpanKind(trace.SpanKindInternal))
+	defer mtrace.EndSpan(span)
+	sql := make([]string, 0)
+	defer func() {
+		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
+	}()
+	err := m.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		conv, err := m.nextSeq(tx, data.Kind, data.FromId, data.ToId, &sql)
+		if err != nil {
+			return err
+		}
+		data.Seq = conv.Seq
+		// 系统消息不参与阅后即焚
+		if conv.Ttl > 0 && data.Type != model.MessageTypeSystem {
+			data.Ttl = conv.Ttl
+			if conv.TtlMode != model.TtlModeRead {
+				expireAt := time.Now().Add(time.Duration(conv.Ttl) * time.Second)
+				data.ExpireAt = &expireAt
+			}
+		}
+		stmt := tx.Create(&data)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Create(&data)
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		stmt = tx.Model(&model.Conversation{}).Where("id=?", conv.ID).Update("last_msg_id", data.ID)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.Conversation{}).Where("id=?", conv.ID).Update("last_msg_id", data.ID)
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		stmt = tx.Model(&model.UserSession{}).
+			Where("user_id=? AND to_id=? AND kind=? AND seq<?", data.FromId, data.ToId, data.Kind, data.Seq).
+			Update("seq", data.Seq)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.UserSession{}).
+				Where("user_id=? AND to_id=? AND kind=? AND seq<?", data.FromId, data.ToId, data.Kind, data.Seq).
+				Update("seq", data.Seq)
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		// 被隐藏的会话收到新消息后重新打开
+		reopen := func(tx *gorm.DB) *gorm.DB {
+			tx = tx.Model(&model.UserSession{}).Where("hidden_at IS NOT NULL")
+			if data.Kind == "group" {
+				tx = tx.Where("kind='group' AND to_id=?", data.ToId)
+			} else {
+				tx = tx.Where("kind='single' AND ((user_id=? AND to_id=?) OR (user_id=? AND to_id=?))",
+					data.FromId, data.ToId, data.ToId, data.FromId)
+			}
+			return tx.Update("hidden_at", nil)
+		}
+		stmt = reopen(tx)
+		sql = append(sql, tx.ToSQL(reopen))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		if len(mentions) == 0 && !mentionAll {
+			return nil
+		}
+		list := make([]*model.MessageMention, 0, len(mentions)+1)
+		if mentionAll {
+			list = append(list, &model.MessageMention{
+				MessageId: data.ID,
+				GroupId:   data.ToId,
+				FromId:    data.FromId,
+				Seq:       data.Seq,
+			})
+		}
+		for _, id := range mentions {
+			list = append(list, &model.MessageMention{
+				MessageId: data.ID,
+				GroupId:   data.ToId,
+				FromId:    data.FromId,
+				UserId:    id,
+				Seq:       data.Seq,
+			})
+		}
+		stmt = tx.Create(list)
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Create(list)
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		return nil
 	})
 	if err != nil {
 		return 0, errors.Wrap(err, "Insert")
@@ -27,10 +129,96 @@ func (m *MessageRepository) Insert(ctx context.Context, data *model.Message) (in
 	return data.ID, nil
 }
 
+// 会话计数器不存在时以历史消息的最大序号初始化，更新语句会锁住该行直到事务结束
+func (m *MessageRepository) nextSeq(tx *gorm.DB, kind string, fromId, toId int64, sql *[]string) (*model.Conversation, error) {
+	a, b := model.ConversationKey(kind, fromId, toId)
+	stmt := tx.Model(&model.Conversation{}).Where("kind=? AND from_id=? AND to_id=?", kind, a, b).
+		Update("seq", gorm.Expr("seq + 1"))
+	*sql = append(*sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.Conversation{}).Where("kind=? AND from_id=? AND to_id=?", kind, a, b).
+			Update("seq", gorm.Expr("seq + 1"))
+	}))
+	if stmt.Error != nil {
+		return nil, stmt.Error
+	}
+	if stmt.RowsAffected == 0 {
+		var maxSeq int64
+		query := tx.Model(&model.Message{}).Select("COALESCE(MAX(seq), 0)")
+		if kind == "group" {
+			query = query.Where("kind='group' AND to_id=?", b)
+		} else {
+			query = query.Where("kind='single' AND ((from_id=? AND to_id=?) OR (from_id=? AND to_id=?))", a, b, b, a)
+		}
+		stmt = query.Scan(&maxSeq)
+		if stmt.Error != nil {
+			return nil, stmt.Error
+		}
+		conv := &model.Conversation{
+			Kind:   kind,
+			FromId: a,
+			ToId:   b,
+			Seq:    maxSeq + 1,
+		}
+		stmt = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "kind"}, {Name: "from_id"}, {Name: "to_id"}},
+			DoUpdates: clause.Assignments(map[string]any{"seq": gorm.Expr("conversation.seq + 1")}),
+		}).Create(conv)
+		*sql = append(*sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "kind"}, {Name: "from_id"}, {Name: "to_id"}},
+				DoUpdates: clause.Assignments(map[string]any{"seq": gorm.Expr("conversation.seq + 1")}),
+			}).Create(conv)
+		}))
+		if stmt.Error != nil {
+			return nil, stmt.Error
+		}
+	}
+	var conv *model.Conversation
+	stmt = tx.First(&conv, "kind=? AND from_id=? AND to_id=?", kind, a, b)
+	if stmt.Error != nil {
+		return nil, stmt.Error
+	}
+	return conv, nil
+}
+
+func (m *MessageRepository) FindByClientMsgId(ctx context.Context, fromId int64, clientMsgId string) (*model.Message, error) {
+	var resp *model.Message
+	err := m.db.Wrap(ctx, "FindByClientMsgId", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "from_id=? AND client_msg_id=?", fromId, clientMsgId)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByClientMsgId")
+	}
+	return resp, nil
+}
+
+func (m *MessageRepository) FindByClientSeq(ctx context.Context, fromId int64, toId int64, kind string, clientSeq int64) (*model.Message, error) {
+	var resp *model.Message
+	err := m.db.Wrap(ctx, "FindByClientSeq", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "from_id=? AND to_id=? AND kind=? AND client_seq=?", fromId, toId, kind, clientSeq)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByClientSeq")
+	}
+	return resp, nil
+}
+
+func (m *MessageRepository) FindOne(ctx context.Context, id int64) (*model.Message, error) {
+	var resp *model.Message
+	err := m.db.Wrap(ctx, "FindOne", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "id=?", id)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindOne")
+	}
+	return resp, nil
+}
+
 func (m *MessageRepository) ListUnRead(ctx context.Context, toId int64, fromId int64, seq int64) ([]*model.Message, error) {
 	var resp []*model.Message
 	err := m.db.Wrap(ctx, "ListUnRead", func(tx *gorm.DB) *gorm.DB {
-		return tx.Find(&resp, "kind='single' AND from_id=? AND to_id=? AND seq >= ?", fromId, toId, seq)
+		return tx.Where(notDeletedFor, toId).Where(notExpired, time.Now()).
+			Find(&resp, "kind='single' AND from_id=? AND to_id=? AND seq >= ?", fromId, toId, seq)
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "ListUnRead")
@@ -38,13 +226,271 @@ func (m *MessageRepository) ListUnRead(ctx context.Context, toId int64, fromId i
 	return resp, nil
 }
 
-func (m *MessageRepository) ListGroupUnRead(ctx context.Context, toId int64, seq int64) ([]*model.Message, error) {
+// CountUnread 统计用户在会话中 seq 之后收到的未撤回、未过期的消息数
+func (m *MessageRepository) CountUnread(ctx context.Context, kind string, userId int64, toId int64, seq int64) (int64, error) {
+	var count int64
+	err := m.db.Wrap(ctx, "CountUnread", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.Message{}).Where("seq>? AND revoked_at IS NULL", seq).Where(notExpired, time.Now())
+		if kind == "group" {
+			tx = tx.Where("kind='group' AND to_id=? AND from_id<>?", toId, userId)
+		} else {
+			tx = tx.Where("from_id=? AND to_id=? AND kind='single'", toId, userId)
+		}
+		return tx.Count(&count)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "CountUnread")
+	}
+	return count, nil
+}
+
+// ListHistory 以 seq 为锚点分页，before 取 seq 之前的消息按 seq 倒序，否则取 seq 之后的消息按 seq 正序，均不含锚点
+// clearedSeq 及之前的消息已被用户清空，不再返回
+// 单聊两个方向分别走 (from_id,to_id,kind,seq) 索引后再合并
+func (m *MessageRepository) ListHistory(ctx context.Context, kind string, userId int64, toId int64, seq int64, clearedSeq int64, before bool, limit int) ([]*model.Message, error) {
+	cond, order := "seq>?", "seq ASC"
+	if before {
+		cond, order = "seq<?", "seq DESC"
+	}
+	var resp []*model.Message
+	if kind == "group" {
+		err := m.db.Wrap(ctx, "ListHistory", func(tx *gorm.DB) *gorm.DB {
+			return tx.Where("kind='group' AND to_id=? AND "+cond, toId, seq).Where("seq>?", clearedSeq).
+				Where(notDeletedFor, userId).Where(notExpired, time.Now()).Order(order).Limit(limit).Find(&resp)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "ListHistory")
+		}
+		return resp, nil
+	}
+	for _, pair := range [][2]int64{{userId, toId}, {toId, userId}} {
+		var list []*model.Message
+		err := m.db.Wrap(ctx, "ListHistory", func(tx *gorm.DB) *gorm.DB {
+			return tx.Where("from_id=? AND to_id=? AND kind='single' AND "+cond, pair[0], pair[1], seq).Where("seq>?", clearedSeq).
+				Where(notDeletedFor, userId).Where(notExpired, time.Now()).Order(order).Limit(limit).Find(&list)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "ListHistory")
+		}
+		resp = append(resp, list...)
+	}
+	slices.SortFunc(resp, func(a, b *model.Message) int {
+		if before {
+			return cmp.Compare(b.Seq, a.Seq)
+		}
+		return cmp.Compare(a.Seq, b.Seq)
+	})
+	if len(resp) > limit {
+		resp = resp[:limit]
+	}
+	return resp, nil
+}
+
+// ListExport 按 seq 正序取 seq 之后、时间范围内的消息用于导出，start、end 为空时不限制
+// all 为 true 时包含用户仅对自己删除的消息
+func (m *MessageRepository) ListExport(ctx context.Context, kind string, userId int64, toId int64, seq int64, start, end *time.Time, all bool, limit int) ([]*model.Message, error) {
+	scope := func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where("seq>?", seq)
+		if start != nil {
+			tx = tx.Where("created_at>=?", *start)
+		}
+		if end != nil {
+			tx = tx.Where("created_at<?", *end)
+		}
+		if !all {
+			tx = tx.Where(notDeletedFor, userId).Where(notExpired, time.Now())
+		}
+		return tx.Order("seq ASC").Limit(limit)
+	}
+	var resp []*model.Message
+	if kind == "group" {
+		err := m.db.Wrap(ctx, "ListExport", func(tx *gorm.DB) *gorm.DB {
+			return tx.Scopes(scope).Where("kind='group' AND to_id=?", toId).Find(&resp)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "ListExport")
+		}
+		return resp, nil
+	}
+	for _, pair := range [][2]int64{{userId, toId}, {toId, userId}} {
+		var list []*model.Message
+		err := m.db.Wrap(ctx, "ListExport", func(tx *gorm.DB) *gorm.DB {
+			return tx.Scopes(scope).Where("from_id=? AND to_id=? AND kind='single'", pair[0], pair[1]).Find(&list)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "ListExport")
+		}
+		resp = append(resp, list...)
+	}
+	slices.SortFunc(resp, func(a, b *model.Message) int {
+		return cmp.Compare(a.Seq, b.Seq)
+	})
+	if len(resp) > limit {
+		resp = resp[:limit]
+	}
+	return resp, nil
+}
+
+func (m *MessageRepository) ListByIds(ctx context.Context, ids []int64) ([]*model.Message, error) {
+	var resp []*model.Message
+	err := m.db.Wrap(ctx, "ListByIds", func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&resp, "id IN ?", ids)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListByIds")
+	}
+	return resp, nil
+}
+
+// ListAliveIds 返回 ids 中未撤回、未过期的消息ID，用于校验检索结果
+func (m *MessageRepository) ListAliveIds(ctx context.Context, ids []int64) (map[int64]struct{}, error) {
+	var list []int64
+	err := m.db.Wrap(ctx, "ListAliveIds", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.Message{}).Where("id IN ? AND revoked_at IS NULL", ids).Where(notExpired, time.Now()).
+			Pluck("id", &list)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListAliveIds")
+	}
+	resp := make(map[int64]struct{}, len(list))
+	for _, id := range list {
+		resp[id] = struct{}{}
+	}
+	return resp, nil
+}
+
+// Revoke 对所有人删除，清空内容只留墓碑
+func (m *MessageRepository) Revoke(ctx context.Context, id int64, userId int64) error {
+	err := m.db.Wrap(ctx, "Revoke", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.Message{}).Where("id=? AND revoked_at IS NULL", id).Updates(map[string]any{
+			"content":    "",
+			"revoked_at": time.Now(),
+			"revoked_by": userId,
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "Revoke")
+	}
+	return nil
+}
+
+// UpdateContent 内容仍是 old 时才更新，返回是否更新成功，用于卡片并发更新
+func (m *MessageRepository) UpdateContent(ctx context.Context, id int64, old string, content string) (bool, error) {
+	var affected int64
+	err := m.db.Wrap(ctx, "UpdateContent", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.Message{}).Where("id=? AND revoked_at IS NULL AND content=?", id, old).Update("content", content)
+		affected = tx.RowsAffected
+		return tx
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "UpdateContent")
+	}
+	return affected > 0, nil
+}
+
+// ListAfterId 按主键顺序分批遍历消息
+func (m *MessageRepository) ListAfterId(ctx context.Context, id int64, limit int) ([]*model.Message, error) {
+	var resp []*model.Message
+	err := m.db.Wrap(ctx, "ListAfterId", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id>?", id).Order("id ASC").Limit(limit).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListAfterId")
+	}
+	return resp, nil
+}
+
+func (m *MessageRepository) ListGroupUnRead(ctx context.Context, userId int64, toId int64, seq int64) ([]*model.Message, error) {
 	var resp []*model.Message
 	err := m.db.Wrap(ctx, "ListGroupUnRead", func(tx *gorm.DB) *gorm.DB {
-		return tx.Find(&resp, "kind='group' AND to_id=? AND seq>=?", toId, seq)
+		return tx.Where(notDeletedFor, userId).Where(notExpired, time.Now()).
+			Find(&resp, "kind='group' AND to_id=? AND seq>=?", toId, seq)
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "ListUnRead")
 	}
 	return resp, nil
 }
+
+// StartExpire 用户已读到 toSeq 后，为 (fromSeq, toSeq] 之间对方发送的 read 模式消息开始计时
+// 群聊在第一个成员已读后开始计时
+func (m *MessageRepository) StartExpire(ctx context.Context, kind string, userId int64, toId int64, fromSeq int64, toSeq int64) error {
+	var list []*model.Message
+	err := m.db.Wrap(ctx, "StartExpire", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Select("id", "ttl").Where("seq>? AND seq<=? AND ttl>0 AND expire_at IS NULL", fromSeq, toSeq)
+		if kind == "group" {
+			tx = tx.Where("kind='group' AND to_id=? AND from_id<>?", toId, userId)
+		} else {
+			tx = tx.Where("kind='single' AND from_id=? AND to_id=?", toId, userId)
+		}
+		return tx.Find(&list)
+	})
+	if err != nil {
+		return errors.Wrap(err, "StartExpire")
+	}
+	// 同一会话中途可能调整过时长，按时长分组更新
+	ttls := make(map[int64][]int64)
+	for _, item := range list {
+		ttls[item.Ttl] = append(ttls[item.Ttl], item.ID)
+	}
+	now := time.Now()
+	for ttl, ids := range ttls {
+		expireAt := now.Add(time.Duration(ttl) * time.Second)
+		err = m.db.Wrap(ctx, "StartExpire", func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&model.Message{}).Where("id IN ? AND expire_at IS NULL", ids).Update("expire_at", expireAt)
+		})
+		if err != nil {
+			return errors.Wrap(err, "StartExpire")
+		}
+	}
+	return nil
+}
+
+func (m *MessageRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.Message, error) {
+	var resp []*model.Message
+	err := m.db.Wrap(ctx, "ListExpired", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("expire_at<=?", now).Order("expire_at ASC").Limit(limit).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListExpired")
+	}
+	return resp, nil
+}
+
+// Purge 物理删除消息及其关联的提及、删除记录
+func (m *MessageRepository) Purge(ctx context.Context, ids []int64) error {
+	_, span := mtrace.StartSpan(ctx, "Purge", trace.WithSpanKind(trace.SpanKindInternal))
+	defer mtrace.EndSpan(span)
+	sql := make([]string, 0)
+	defer func() {
+		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
+	}()
+	err := m.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		stmt := tx.Unscoped().Where("message_id IN ?", ids).Delete(&model.MessageMention{})
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Unscoped().Where("message_id IN ?", ids).Delete(&model.MessageMention{})
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		stmt = tx.Unscoped().Where("message_id IN ?", ids).Delete(&model.MessageDeletion{})
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Unscoped().Where("message_id IN ?", ids).Delete(&model.MessageDeletion{})
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		stmt = tx.Unscoped().Where("id IN ?", ids).Delete(&model.Message{})
+		sql = append(sql, tx.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Unscoped().Where("id IN ?", ids).Delete(&model.Message{})
+		}))
+		if stmt.Error != nil {
+			return stmt.Error
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Purge")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/db"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MessageDeletionRepository struct {
+	db *db.DB
+}
+
+func NewMessageDeletionRepository(db *db.DB) *MessageDeletionRepository {
+	return &MessageDeletionRepository{db}
+}
+
+func (m *MessageDeletionRepository) Create(ctx context.Context, userId int64, messageId int64) error {
+	data := &model.MessageDeletion{
+		UserId:    userId,
+		MessageId: messageId,
+	}
+	err := m.db.Wrap(ctx, "Create", func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(data)
+	})
+	if err != nil {
+		return errors.Wrap(err, "Create")
+	}
+	return nil
+}
+
+// ListDeleted 返回 messageIds 中被该用户删除的部分
+func (m *MessageDeletionRepository) ListDeleted(ctx context.Context, userId int64, messageIds []int64) (map[int64]struct{}, error) {
+	var ids []int64
+	err := m.db.Wrap(ctx, "ListDeleted", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.MessageDeletion{}).Where("user_id=? AND message_id IN ?", userId, messageIds).Pluck("message_id", &ids)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListDeleted")
+	}
+	resp := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		resp[id] = struct{}{}
+	}
+	return resp, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/db"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type MessageMentionRepository struct {
+	db *db.DB
+}
+
+func NewMessageMentionRepository(db *db.DB) *MessageMentionRepository {
+	return &MessageMentionRepository{db}
+}
+
+func (m *MessageMentionRepository) ListByMessageIds(ctx context.Context, messageIds []int64) ([]*model.MessageMention, error) {
+	resp := make([]*model.MessageMention, 0)
+	err := m.db.Wrap(ctx, "ListByMessageIds", func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&resp, "message_id IN ?", messageIds)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListByMessageIds")
+	}
+	return resp, nil
+}
+
+func (m *MessageMentionRepository) CountUnread(ctx context.Context, groupId int64, userId int64, seq int64) (int64, error) {
+	var count int64
+	err := m.db.Wrap(ctx, "CountUnread", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.MessageMention{}).
+			Where("group_id=? AND (user_id=? OR user_id=0) AND from_id<>? AND seq>?", groupId, userId, userId, seq).
+			Count(&count)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "CountUnread")
+	}
+	return count, nil
+}
+
+// CountUnreadByGroup 批量统计用户在各群未读的@数，seqs 为群ID到已读序号
+func (m *MessageMentionRepository) CountUnreadByGroup(ctx context.Context, userId int64, seqs map[int64]int64) (map[int64]int64, error) {
+	var rows []struct {
+		GroupId int64
+		Count   int64
+	}
+	conds := make([]string, 0, len(seqs))
+	args := make([]any, 0, 2*len(seqs))
+	for groupId, seq := range seqs {
+		conds = append(conds, "(group_id=? AND seq>?)")
+		args = append(args, groupId, seq)
+	}
+	err := m.db.Wrap(ctx, "CountUnreadByGroup", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.MessageMention{}).Select("group_id, COUNT(*) AS count").
+			Where("(user_id=? OR user_id=0) AND from_id<>?", userId, userId).
+			Where(strings.Join(conds, " OR "), args...).Group("group_id").Scan(&rows)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "CountUnreadByGroup")
+	}
+	resp := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		resp[row.GroupId] = row.Count
+	}
+	return resp, nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/db"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ScheduledMessageRepository struct {
+	db *db.DB
+}
+
+func NewScheduledMessageRepository(db *db.DB) *ScheduledMessageRepository {
+	return &ScheduledMessageRepository{db}
+}
+
+func (s *ScheduledMessageRepository) Create(ctx context.Context, data *model.ScheduledMessage) (int64, error) {
+	err := s.db.Wrap(ctx, "Create", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(data)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Create")
+	}
+	return data.ID, nil
+}
+
+func (s *ScheduledMessageRepository) FindOne(ctx context.Context, id int64) (*model.ScheduledMessage, error) {
+	var resp *model.ScheduledMessage
+	err := s.db.Wrap(ctx, "FindOne", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "id=?", id)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindOne")
+	}
+	return resp, nil
+}
+
+func (s *ScheduledMessageRepository) ListByUser(ctx context.Context, userId int64, status []string) ([]*model.ScheduledMessage, error) {
+	var resp []*model.ScheduledMessage
+	err := s.db.Wrap(ctx, "ListByUser", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("user_id=? AND status IN ?", userId, status).Order("send_at ASC").Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListByUser")
+	}
+	return resp, nil
+}
+
+// UpdatePending 只修改仍在等待发送的记录，返回是否修改成功
+func (s *ScheduledMessageRepository) UpdatePending(ctx context.Context, id int64, userId int64, values map[string]any) (bool, error) {
+	var affected int64
+	err := s.db.Wrap(ctx, "UpdatePending", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.ScheduledMessage{}).
+			Where("id=? AND user_id=? AND status=?", id, userId, model.ScheduleStatusPending).
+			Updates(values)
+		affected = tx.RowsAffected
+		return tx
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "UpdatePending")
+	}
+	return affected > 0, nil
+}
+
+func (s *ScheduledMessageRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.ScheduledMessage, error) {
+	var resp []*model.ScheduledMessage
+	err := s.db.Wrap(ctx, "ListDue", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("status=? AND send_at<=?", model.ScheduleStatusPending, now).
+			Order("send_at ASC").Limit(limit).Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListDue")
+	}
+	return resp, nil
+}
+
+// Claim 把待发送记录标记为发送中，返回是否抢到
+func (s *ScheduledMessageRepository) Claim(ctx context.Context, id int64) (bool, error) {
+	var affected int64
+	err := s.db.Wrap(ctx, "Claim", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.ScheduledMessage{}).
+			Where("id=? AND status=?", id, model.ScheduleStatusPending).
+			Update("status", model.ScheduleStatusSending)
+		affected = tx.RowsAffected
+		return tx
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "Claim")
+	}
+	return affected > 0, nil
+}
+
+func (s *ScheduledMessageRepository) Finish(ctx context.Context, id int64, status string, messageId int64, errMsg string) error {
+	err := s.db.Wrap(ctx, "Finish", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.ScheduledMessage{}).Where("id=?", id).Updates(map[string]any{
+			"status":     status,
+			"message_id": messageId,
+			"error":      errMsg,
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "Finish")
+	}
+	return nil
+}
+
+// ResetStuck 发送中途进程退出的记录重新放回待发送，依靠 client_msg_id 去重不会重复发送
+func (s *ScheduledMessageRepository) ResetStuck(ctx context.Context, before time.Time) error {
+	err := s.db.Wrap(ctx, "ResetStuck", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.ScheduledMessage{}).
+			Where("status=? AND updated_at<?", model.ScheduleStatusSending, before).
+			Update("status", model.ScheduleStatusPending)
+	})
+	if err != nil {
+		return errors.Wrap(err, "ResetStuck")
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"go-im/internal/pkg/db"
 	"go-im/internal/pkg/mtrace"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/trace"
@@ -21,16 +22,43 @@ func NewUserSessionRepository(db *db.DB) *UserSessionRepository {
 	return &UserSessionRepository{db}
 }
 
-func (u *UserSessionRepository) Delete(ctx context.Context, id int64) error {
-	err := u.db.Wrap(ctx, "Delete", func(tx *gorm.DB) *gorm.DB {
-		return tx.Delete(&model.UserSession{}, "id=?", id)
+// Hide 隐藏会话并清空 clearedSeq 及之前的消息，行保留以便新消息到达时重新打开
+func (u *UserSessionRepository) Hide(ctx context.Context, id int64, clearedSeq int64) error {
+	err := u.db.Wrap(ctx, "Hide", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.UserSession{}).Where("id=?", id).Updates(map[string]any{
+			"hidden_at":   time.Now(),
+			"cleared_seq": gorm.Expr("GREATEST(cleared_seq, ?)", clearedSeq),
+			"seq":         gorm.Expr("GREATEST(seq, ?)", clearedSeq),
+		})
 	})
 	if err != nil {
-		return errors.Wrap(err, "Delete")
+		return errors.Wrap(err, "Hide")
 	}
 	return nil
 }
 
+func (u *UserSessionRepository) FindOne(ctx context.Context, id int64) (*model.UserSession, error) {
+	var resp *model.UserSession
+	err := u.db.Wrap(ctx, "FindOne", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "id=?", id)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindOne")
+	}
+	return resp, nil
+}
+
+func (u *UserSessionRepository) ListGroupSession(ctx context.Context, groupId int64) ([]*model.UserSession, error) {
+	var resp []*model.UserSession
+	err := u.db.Wrap(ctx, "ListGroupSession", func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&resp, "kind='group' AND to_id=?", groupId)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListGroupSession")
+	}
+	return resp, nil
+}
+
 func (u *UserSessionRepository) GetUserSession(ctx context.Context, userId int64, to int64) (*model.UserSession, error) {
 	var resp *model.UserSession
 	err := u.db.Wrap(ctx, "GetUserSession", func(tx *gorm.DB) *gorm.DB {
@@ -42,6 +70,17 @@ func (u *UserSessionRepository) GetUserSession(ctx context.Context, userId int64
 	return resp, nil
 }
 
+func (u *UserSessionRepository) FindByPeer(ctx context.Context, userId int64, toId int64, kind string) (*model.UserSession, error) {
+	var resp *model.UserSession
+	err := u.db.Wrap(ctx, "FindByPeer", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "user_id=? AND to_id=? AND kind=?", userId, toId, kind)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByPeer")
+	}
+	return resp, nil
+}
+
 func (u *UserSessionRepository) ListUserSession(ctx context.Context, userId int64) ([]*model.UserSession, error) {
 	var resp []*model.UserSession
 	err := u.db.Wrap(ctx, "ListUserSession", func(tx *gorm.DB) *gorm.DB {
@@ -53,6 +92,48 @@ func (u *UserSessionRepository) ListUserSession(ctx context.Context, userId int6
 	return resp, nil
 }
 
+// ListUnreadUserIds 返回还没读到 msg 的接收者，发送者不计
+func (u *UserSessionRepository) ListUnreadUserIds(ctx context.Context, msg *model.Message) ([]int64, error) {
+	var ids []int64
+	err := u.db.Wrap(ctx, "ListUnreadUserIds", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.UserSession{}).Where("seq<?", msg.Seq)
+		if msg.Kind == "group" {
+			tx = tx.Where("kind='group' AND to_id=? AND user_id<>?", msg.ToId, msg.FromId)
+		} else {
+			tx = tx.Where("kind='single' AND user_id=? AND to_id=?", msg.ToId, msg.FromId)
+		}
+		return tx.Pluck("user_id", &ids)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListUnreadUserIds")
+	}
+	return ids, nil
+}
+
+// ListUserIds 按用户ID升序返回有会话的用户
+func (u *UserSessionRepository) ListUserIds(ctx context.Context, afterId int64, limit int) ([]int64, error) {
+	var ids []int64
+	err := u.db.Wrap(ctx, "ListUserIds", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.UserSession{}).Distinct("user_id").Where("user_id>?", afterId).
+			Order("user_id ASC").Limit(limit).Pluck("user_id", &ids)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListUserIds")
+	}
+	return ids, nil
+}
+
+// UpdateFlag 设置或清除置顶、免打扰、归档时间，at 为 nil 表示清除
+func (u *UserSessionRepository) UpdateFlag(ctx context.Context, id int64, column string, at *time.Time) error {
+	err := u.db.Wrap(ctx, "UpdateFlag", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.UserSession{}).Where("id=?", id).Update(column, at)
+	})
+	if err != nil {
+		return errors.Wrap(err, "UpdateFlag")
+	}
+	return nil
+}
+
 func (u *UserSessionRepository) UpdateSessionSeq(ctx context.Context, id int64, seq int64) error {
 	err := u.db.Wrap(ctx, "UpdateSessionSeq", func(tx *gorm.DB) *gorm.DB {
 		return tx.Model(&model.UserSession{}).Where("id=? AND seq < ?", id, seq).Update("seq", seq)
@@ -70,7 +151,7 @@ func (u *UserSessionRepository) Upsert(ctx context.Context, s *model.UserSession
 	defer func() {
 		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
 	}()
-	err := u.db.Transaction(func(tx *gorm.DB) error {
+	err := u.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
 		stmt := tx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "user_id"}},
 			DoNothing: true,
@@ -117,7 +198,7 @@ func (u *UserSessionRepository) Create(ctx context.Context, s *model.UserSession
 	defer func() {
 		span.SetAttributes(mtrace.SQLKey.String(strings.Join(sql, "; ")))
 	}()
-	stmt := u.db.Clauses(clause.OnConflict{
+	stmt := u.db.Conn(ctx).Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "user_id"}},
 		DoNothing: true,
 	}).Create(&s)
@@ -131,12 +212,24 @@ func (u *UserSessionRepository) Create(ctx context.Context, s *model.UserSession
 		return 0, errors.Wrap(stmt.Error, "Create")
 	}
 	var resp *model.UserSession
-	stmt = u.db.First(&resp, "user_id=? AND to_id=? AND kind=?", s.UserId, s.ToId, s.Kind)
+	stmt = u.db.Conn(ctx).Unscoped().First(&resp, "user_id=? AND to_id=? AND kind=?", s.UserId, s.ToId, s.Kind)
 	sql = append(sql, u.db.ToSQL(func(tx *gorm.DB) *gorm.DB {
-		return tx.First(&resp, "user_id=? AND to_id=? AND kind=?", s.UserId, s.ToId, s.Kind)
+		return tx.Unscoped().First(&resp, "user_id=? AND to_id=? AND kind=?", s.UserId, s.ToId, s.Kind)
 	}))
 	if stmt.Error != nil {
 		return 0, errors.Wrap(stmt.Error, "Create")
 	}
+	// 已隐藏或旧版本删除的会话重新打开
+	if resp.HiddenAt != nil || resp.DeletedAt.Valid {
+		stmt = u.db.Conn(ctx).Unscoped().Model(&model.UserSession{}).Where("id=?", resp.ID).
+			Updates(map[string]any{"hidden_at": nil, "deleted_at": nil})
+		sql = append(sql, u.db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Unscoped().Model(&model.UserSession{}).Where("id=?", resp.ID).
+				Updates(map[string]any{"hidden_at": nil, "deleted_at": nil})
+		}))
+		if stmt.Error != nil {
+			return 0, errors.Wrap(stmt.Error, "Create")
+		}
+	}
 	return resp.ID, nil
 }
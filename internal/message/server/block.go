@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"slices"
+)
+
+// checkBlocked 接收方拉黑了发送方时拒绝单聊消息
+func (s *Server) checkBlocked(ctx context.Context, fromId, toId int64) error {
+	ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+	defer cancel()
+	resp, err := s.userRpc.CheckBlocked(ctx, &user.CheckBlockedReq{UserId: toId, TargetIds: []int64{fromId}})
+	if err != nil {
+		return errcode.FromRpcError(err)
+	}
+	if len(resp.BlockedIds) > 0 {
+		return errcode.ErrBlocked
+	}
+	return nil
+}
+
+// listHiders 隐藏了发送者群消息的用户，失败时不隐藏
+func (s *Server) listHiders(ctx context.Context, fromId int64) []int64 {
+	ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+	defer cancel()
+	resp, err := s.userRpc.ListHiders(ctx, &user.ListHidersReq{UserId: fromId})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil
+	}
+	return resp.UserIds
+}
+
+// filterHidden 去掉 userId 选择隐藏的发送者的群消息
+func (s *Server) filterHidden(ctx context.Context, userId int64, list []*model.Message) ([]*model.Message, error) {
+	fromIds := make([]int64, 0, len(list))
+	for _, item := range list {
+		if item.Kind == "group" && item.FromId != userId {
+			fromIds = append(fromIds, item.FromId)
+		}
+	}
+	if len(fromIds) == 0 {
+		return list, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+	defer cancel()
+	resp, err := s.userRpc.CheckBlocked(ctx, &user.CheckBlockedReq{
+		UserId:    userId,
+		TargetIds: slices.Compact(slices.Sorted(slices.Values(fromIds))),
+	})
+	if err != nil {
+		return nil, errcode.FromRpcError(err)
+	}
+	if len(resp.HiddenIds) == 0 {
+		return list, nil
+	}
+	return slices.DeleteFunc(list, func(item *model.Message) bool {
+		return item.Kind == "group" && slices.Contains(resp.HiddenIds, item.FromId)
+	}), nil
+}
@@ -0,0 +1,342 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/access"
+	"go-im/api/message"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/protocol"
+	"go-im/internal/common/types"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"go-im/internal/pkg/redis"
+	"slices"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	broadcasterLeaseKey = "message:broadcaster:lease"
+	broadcasterLeaseTTL = 10 * time.Second
+	broadcasterInterval = 2 * time.Second
+	broadcastBatch      = 500
+	// 每轮每条公告最多处理的批次，避免大范围公告占满租约时间
+	broadcastPagesPerTick = 20
+
+	maxBroadcastGroups    = 100
+	defaultBroadcastLimit = 20
+	maxBroadcastLimit     = 100
+
+	systemSessionName = "系统通知"
+)
+
+func (s *Server) CreateBroadcast(ctx context.Context, in *message.CreateBroadcastReq) (*message.CreateBroadcastResp, error) {
+	if in.Title == "" || in.Content == "" {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	b := &model.Broadcast{
+		OperatorId: in.OperatorId,
+		Title:      in.Title,
+		Content:    in.Content,
+		Segment:    in.Segment,
+		Status:     model.BroadcastStatusPending,
+	}
+	switch in.Segment {
+	case model.BroadcastSegmentAll:
+	case model.BroadcastSegmentRegisteredAfter:
+		if in.RegisteredAfter <= 0 {
+			return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+		}
+		t := time.UnixMilli(in.RegisteredAfter)
+		b.RegisteredAfter = &t
+	case model.BroadcastSegmentGroups:
+		ids := slices.Compact(slices.Sorted(slices.Values(in.GroupIds)))
+		if len(ids) == 0 || len(ids) > maxBroadcastGroups {
+			return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+		}
+		v, _ := mjson.Marshal(ids)
+		b.GroupIds = string(v)
+	default:
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	id, err := s.broadcastRepository.Create(ctx, b)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.CreateBroadcastResp{
+		Id: id,
+	}, nil
+}
+
+func (s *Server) GetBroadcastStats(ctx context.Context, in *message.GetBroadcastStatsReq) (*message.GetBroadcastStatsResp, error) {
+	b, err := s.broadcastRepository.FindOne(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrBroadcastNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.GetBroadcastStatsResp{
+		Id:             b.ID,
+		Title:          b.Title,
+		Segment:        b.Segment,
+		Status:         b.Status,
+		TotalCount:     b.TotalCount,
+		DeliveredCount: b.DeliveredCount,
+		ReadCount:      b.ReadCount,
+		CreateTime:     b.CreatedAt.UnixMilli(),
+	}, nil
+}
+
+func (s *Server) ListBroadcast(ctx context.Context, in *message.ListBroadcastReq) (*message.ListBroadcastResp, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultBroadcastLimit
+	}
+	limit = min(limit, maxBroadcastLimit)
+	receipts, err := s.broadcastRepository.ListReceipt(ctx, in.UserId, in.BeforeId, limit)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &message.ListBroadcastResp{
+		List: make([]*message.BroadcastInfo, 0, len(receipts)),
+	}
+	if len(receipts) == 0 {
+		return resp, nil
+	}
+	ids := make([]int64, 0, len(receipts))
+	for _, receipt := range receipts {
+		ids = append(ids, receipt.BroadcastId)
+	}
+	list, err := s.broadcastRepository.ListByIds(ctx, ids)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	broadcasts := make(map[int64]*model.Broadcast, len(list))
+	for _, b := range list {
+		broadcasts[b.ID] = b
+	}
+	for _, receipt := range receipts {
+		b, ok := broadcasts[receipt.BroadcastId]
+		if !ok {
+			continue
+		}
+		resp.List = append(resp.List, &message.BroadcastInfo{
+			Id:       b.ID,
+			Title:    b.Title,
+			Content:  b.Content,
+			SendTime: b.CreatedAt.UnixMilli(),
+			Read:     receipt.ReadAt != nil,
+		})
+	}
+	s.markDelivered(ctx, in.UserId)
+	return resp, nil
+}
+
+func (s *Server) ReadBroadcast(ctx context.Context, in *message.ReadBroadcastReq) (*message.ReadBroadcastResp, error) {
+	err := s.broadcastRepository.MarkRead(ctx, in.UserId, in.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrBroadcastNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.ReadBroadcastResp{}, nil
+}
+
+// systemSession 收到过公告的用户在会话列表中多一个系统会话，拉取会话列表即视为送达
+func (s *Server) systemSession(ctx context.Context, userId int64) (*message.SessionInfo, error) {
+	receipts, err := s.broadcastRepository.ListReceipt(ctx, userId, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) == 0 {
+		return nil, nil
+	}
+	b, err := s.broadcastRepository.FindOne(ctx, receipts[0].BroadcastId)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := s.broadcastRepository.CountUnread(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	// 已读的回执一定已送达，没有未读时不用再查
+	if unread > 0 {
+		s.markDelivered(ctx, userId)
+	}
+	return &message.SessionInfo{
+		Kind:        "system",
+		UnreadCount: unread,
+		LastMessage: &message.LastMessage{
+			Id:       b.ID,
+			FromName: systemSessionName,
+			Type:     "text",
+			Excerpt:  excerpt(b.Title),
+			SendTime: b.CreatedAt.UnixMilli(),
+		},
+	}, nil
+}
+
+// markDelivered 先用不加锁的查询判断，只有存在未送达回执时才开事务标记
+func (s *Server) markDelivered(ctx context.Context, userId int64) {
+	ok, err := s.broadcastRepository.HasUndelivered(ctx, userId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	if err := s.broadcastRepository.MarkDelivered(ctx, userId); err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// runBroadcaster 只有持有租约的副本分批写入回执并推送给在线用户
+func (s *Server) runBroadcaster() {
+	lease := redis.NewLease(s.redis, broadcasterLeaseKey, broadcasterLeaseTTL)
+	t := time.NewTicker(broadcasterInterval)
+	defer t.Stop()
+	for range t.C {
+		ctx := context.Background()
+		ok, err := lease.Acquire(ctx)
+		if err != nil {
+			log.Errorf("acquire broadcaster lease failed, err: %v", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		list, err := s.broadcastRepository.ListUnfinished(ctx, 10)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			continue
+		}
+		for _, b := range list {
+			for range broadcastPagesPerTick {
+				done, err := s.fanoutBroadcast(ctx, b)
+				if err != nil {
+					log.Errorf("fanout broadcast %d failed, err: %v", b.ID, err)
+				}
+				if done || err != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+// fanoutBroadcast 处理一批目标用户，返回是否已经投递完
+func (s *Server) fanoutBroadcast(ctx context.Context, b *model.Broadcast) (bool, error) {
+	ids, err := s.broadcastAudience(ctx, b)
+	if err != nil {
+		return false, err
+	}
+	done := len(ids) < broadcastBatch
+	online := s.onlineUsers(ctx, ids)
+	delivered := make(map[int64]bool, len(online))
+	for _, id := range online {
+		delivered[id] = true
+	}
+	moved := false
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		ok, err := s.broadcastRepository.Fanout(ctx, b.ID, b.LastUserId, ids, delivered, done)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			moved = true
+			return nil
+		}
+		if len(online) == 0 {
+			return nil
+		}
+		v, _ := mjson.Marshal(&access.BroadcastMsg{
+			Id:       b.ID,
+			Title:    b.Title,
+			Content:  b.Content,
+			SendTime: b.CreatedAt.UnixMilli(),
+			ToId:     online,
+		})
+		return s.push(ctx, protocol.PushBody{
+			Type: protocol.BroadcastTopic,
+			Key:  fmt.Appendf([]byte{}, "%d", protocol.BroadcastMsg),
+			Body: v,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	// 游标已被其他副本推进，下一轮重新加载
+	if moved {
+		return true, nil
+	}
+	if len(ids) > 0 {
+		b.LastUserId = ids[len(ids)-1]
+	}
+	return done, nil
+}
+
+// broadcastAudience 按用户ID升序返回游标之后的一批目标用户
+func (s *Server) broadcastAudience(ctx context.Context, b *model.Broadcast) ([]int64, error) {
+	if b.Segment == model.BroadcastSegmentGroups {
+		var groupIds []int64
+		if err := mjson.Unmarshal([]byte(b.GroupIds), &groupIds); err != nil {
+			return nil, err
+		}
+		return s.groupMemberRepository.ListUserIdsInGroups(ctx, groupIds, b.LastUserId, broadcastBatch)
+	}
+	req := &user.ListUserIdsReq{
+		AfterId: b.LastUserId,
+		Limit:   broadcastBatch,
+	}
+	if b.Segment == model.BroadcastSegmentRegisteredAfter && b.RegisteredAfter != nil {
+		req.RegisteredAfter = b.RegisteredAfter.UnixMilli()
+	}
+	ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+	defer cancel()
+	resp, err := s.userRpc.ListUserIds(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.UserIds, nil
+}
+
+// onlineUsers 用一次 pipeline 批量判断在线状态
+func (s *Server) onlineUsers(ctx context.Context, ids []int64) []int64 {
+	if len(ids) == 0 {
+		return nil
+	}
+	pipe := s.redis.Pipeline()
+	cmds := make([]*goredis.IntCmd, 0, len(ids))
+	for _, id := range ids {
+		cmds = append(cmds, pipe.Exists(ctx, fmt.Sprintf(types.CacheOnlineKey, id)))
+	}
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		ret, err := pipe.Exec(ctx)
+		return ret, "pipeline exists online", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil
+	}
+	online := make([]int64, 0)
+	for i, cmd := range cmds {
+		if cmd.Val() > 0 {
+			online = append(online, ids[i])
+		}
+	}
+	return online
+}
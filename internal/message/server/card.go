@@ -0,0 +1,222 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/access"
+	"go-im/api/message"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/protocol"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"go-im/internal/pkg/webhook"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// 回调等待机器人响应的时间，超时后客户端展示错误状态
+	cardCallbackTimeout = 3 * time.Second
+	// 并发更新同一张卡片时的重试次数
+	maxCardUpdateRetry = 3
+)
+
+// cardActionData 按钮回调发送给机器人的内容
+type cardActionData struct {
+	MessageId int64             `json:"message_id"`
+	Kind      string            `json:"kind"`
+	FromId    int64             `json:"from_id"`
+	ToId      int64             `json:"to_id"`
+	UserId    int64             `json:"user_id"`
+	Action    *model.CardAction `json:"action"`
+	Card      *model.Card       `json:"card"`
+}
+
+// cardActionResult 机器人的响应，带 card 时原地更新卡片
+type cardActionResult struct {
+	Card *model.Card `json:"card"`
+}
+
+func (s *Server) CardCallback(ctx context.Context, in *message.CardCallbackReq) (*message.CardCallbackResp, error) {
+	msgs, err := s.readableMessages(ctx, in.UserId, []int64{in.MessageId})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	msg := msgs[0]
+	if msg.Type != model.MessageTypeCard {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	card, err := parseCard(msg.Content)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	action := card.Action(in.ActionId)
+	if action == nil {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	data, _ := mjson.Marshal(&cardActionData{
+		MessageId: msg.ID,
+		Kind:      msg.Kind,
+		FromId:    msg.FromId,
+		ToId:      msg.ToId,
+		UserId:    in.UserId,
+		Action:    action,
+		Card:      card,
+	})
+	// 只回调给发送卡片的机器人
+	callCtx, cancel := context.WithTimeout(ctx, cardCallbackTimeout)
+	defer cancel()
+	b, err := s.webhook.Call(callCtx, &webhook.Event{
+		Type:    webhook.EventCardAction,
+		UserIds: []int64{msg.FromId},
+		Data:    data,
+	})
+	if err != nil {
+		log.Errorf("card callback to bot %d failed, err: %v", msg.FromId, err)
+		return nil, errcode.ToRpcError(errcode.ErrBotNoResponse)
+	}
+	resp := &message.CardCallbackResp{}
+	var result cardActionResult
+	if len(b) == 0 || mjson.Unmarshal(b, &result) != nil || result.Card == nil {
+		return resp, nil
+	}
+	if err := result.Card.Validate(); err != nil {
+		log.Errorf("bot %d responded invalid card, err: %v", msg.FromId, err)
+		return resp, nil
+	}
+	resp.Content, _, err = s.updateCard(ctx, msg, result.Card)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return resp, nil
+}
+
+func (s *Server) UpdateCard(ctx context.Context, in *message.UpdateCardReq) (*message.UpdateCardResp, error) {
+	card, err := parseCard(in.Content)
+	if err != nil {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	msg, err := s.messageRepository.FindOne(ctx, in.MessageId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrMessageNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	// 只有发送卡片的机器人可以更新
+	if msg.FromId != in.BotId || msg.Type != model.MessageTypeCard || msg.RevokedAt != nil {
+		return nil, errcode.ToRpcError(errcode.ErrMessageNotExists)
+	}
+	_, version, err := s.updateCard(ctx, msg, card)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.UpdateCardResp{
+		Version: int32(version),
+	}, nil
+}
+
+// updateCard 以旧内容做条件更新卡片并推送给在线的会话成员，冲突时基于最新内容重试
+func (s *Server) updateCard(ctx context.Context, msg *model.Message, card *model.Card) (string, int, error) {
+	sessionId, err := s.boxId(ctx, msg)
+	if err != nil {
+		return "", 0, err
+	}
+	participants := []int64{msg.FromId, msg.ToId}
+	if msg.Kind == "group" {
+		members, err := s.groupMemberRepository.ListMember(ctx, msg.ToId)
+		if err != nil {
+			return "", 0, err
+		}
+		participants = participants[:0]
+		for _, member := range members {
+			participants = append(participants, member.UserId)
+		}
+	}
+	event := &access.CardUpdatedMsg{
+		MessageId: msg.ID,
+		Kind:      msg.Kind,
+		SessionId: sessionId,
+		Seq:       msg.Seq,
+		FromId:    msg.FromId,
+		PeerId:    msg.ToId,
+		ToId:      s.onlineUsers(ctx, participants),
+	}
+	for range maxCardUpdateRetry {
+		old, err := parseCard(msg.Content)
+		if err != nil {
+			return "", 0, err
+		}
+		card.Version = old.Version + 1
+		b, _ := mjson.Marshal(card)
+		event.Content = string(b)
+		var updated bool
+		err = s.db.InTx(ctx, func(ctx context.Context) error {
+			updated, err = s.messageRepository.UpdateContent(ctx, msg.ID, msg.Content, event.Content)
+			if err != nil || !updated {
+				return err
+			}
+			// 没人在线也要推送，接入层需要更新消息盒子
+			b, _ := mjson.Marshal(event)
+			return s.push(ctx, protocol.PushBody{
+				Type: protocol.MessageEventTopic,
+				Key:  fmt.Appendf([]byte{}, "%d", protocol.CardUpdatedMsg),
+				Body: b,
+			})
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		if updated {
+			return event.Content, card.Version, nil
+		}
+		msg, err = s.messageRepository.FindOne(ctx, msg.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", 0, errcode.ErrMessageNotExists
+			}
+			return "", 0, err
+		}
+		if msg.RevokedAt != nil {
+			return "", 0, errcode.ErrMessageNotExists
+		}
+	}
+	return "", 0, errcode.ErrTooManyRequests
+}
+
+// checkCard 卡片只能由机器人发送
+func (s *Server) checkCard(ctx context.Context, userId int64, content string) error {
+	if _, err := parseCard(content); err != nil {
+		return errcode.ErrInvalidParam
+	}
+	ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+	defer cancel()
+	info, err := s.userRpc.UserInfo(ctx, &user.UserInfoReq{UserId: userId})
+	if err != nil {
+		return errcode.FromRpcError(err)
+	}
+	if !info.IsBot {
+		return errcode.ErrForbidden
+	}
+	return nil
+}
+
+func parseCard(content string) (*model.Card, error) {
+	var card model.Card
+	if err := mjson.Unmarshal([]byte(content), &card); err != nil {
+		return nil, model.ErrInvalidCard
+	}
+	if err := card.Validate(); err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/access"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/protocol"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const defaultRevokeWindow = 2 * time.Minute
+
+func (s *Server) DeleteMessage(ctx context.Context, in *message.DeleteMessageReq) (*message.DeleteMessageResp, error) {
+	msg, err := s.messageRepository.FindOne(ctx, in.MessageId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrMessageNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	var ownerId int64
+	if msg.Kind == "group" {
+		isMember, err := s.groupMemberRepository.IsMember(ctx, msg.ToId, in.UserId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if !isMember {
+			return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
+		}
+		group, err := s.groupRepository.FindOne(ctx, msg.ToId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		ownerId = group.OwnerId
+	} else if msg.FromId != in.UserId && msg.ToId != in.UserId {
+		return nil, errcode.ToRpcError(errcode.ErrMessageNotExists)
+	}
+
+	event := &access.MessageDeletedMsg{
+		MessageId:   msg.ID,
+		Kind:        msg.Kind,
+		Seq:         msg.Seq,
+		FromId:      msg.FromId,
+		PeerId:      msg.ToId,
+		ForEveryone: in.ForEveryone,
+		OperatorId:  in.UserId,
+	}
+	if !in.ForEveryone {
+		// 接收方删除单聊消息时顺带清理自己的消息盒子，群聊消息盒子是共享的不能删
+		if msg.Kind == "single" && msg.ToId == in.UserId {
+			event.SessionId, err = s.boxId(ctx, msg)
+			if err != nil {
+				log.Errorf("err: %v", err)
+				return nil, errcode.ToRpcError(err)
+			}
+		}
+		// 同步给自己的其他在线设备
+		if s.isUserOnline(ctx, in.UserId) {
+			event.ToId = []int64{in.UserId}
+		}
+		err = s.db.InTx(ctx, func(ctx context.Context) error {
+			if err := s.deletionRepository.Create(ctx, in.UserId, msg.ID); err != nil {
+				return err
+			}
+			if len(event.ToId) > 0 || event.SessionId > 0 {
+				return s.pushDeleted(ctx, event)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		return &message.DeleteMessageResp{}, nil
+	}
+
+	if msg.RevokedAt != nil {
+		return &message.DeleteMessageResp{}, nil
+	}
+	// 群主可随时删除，发送者只能在时限内删除
+	isOwner := msg.Kind == "group" && in.UserId == ownerId
+	if !isOwner {
+		if msg.FromId != in.UserId {
+			return nil, errcode.ToRpcError(errcode.ErrRevokeDenied)
+		}
+		if time.Since(msg.CreatedAt) > s.revokeWindow {
+			return nil, errcode.ToRpcError(errcode.ErrRevokeExpired)
+		}
+	}
+	event.SessionId, err = s.boxId(ctx, msg)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	participants := []int64{msg.FromId, msg.ToId}
+	if msg.Kind == "group" {
+		members, err := s.groupMemberRepository.ListMember(ctx, msg.ToId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		participants = participants[:0]
+		for _, member := range members {
+			participants = append(participants, member.UserId)
+		}
+	}
+	for _, id := range participants {
+		if s.isUserOnline(ctx, id) {
+			event.ToId = append(event.ToId, id)
+		}
+	}
+	// 即使没人在线也要推送，接入层需要清理消息盒子
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if err := s.messageRepository.Revoke(ctx, msg.ID, in.UserId); err != nil {
+			return err
+		}
+		return s.pushDeleted(ctx, event)
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if err := s.search.Delete(ctx, msg.ID); err != nil {
+		log.Errorf("err: %v", err)
+	}
+	s.decrUnread(ctx, msg)
+	return &message.DeleteMessageResp{}, nil
+}
+
+// boxId 接入层消息盒子的键：单聊为接收方的会话，群聊为群ID
+func (s *Server) boxId(ctx context.Context, msg *model.Message) (int64, error) {
+	if msg.Kind == "group" {
+		return msg.ToId, nil
+	}
+	session, err := s.userSessionRepository.FindByPeer(ctx, msg.ToId, msg.FromId, msg.Kind)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return session.ID, nil
+}
+
+func (s *Server) pushDeleted(ctx context.Context, event *access.MessageDeletedMsg) error {
+	b, _ := mjson.Marshal(event)
+	return s.push(ctx, protocol.PushBody{
+		Type: protocol.MessageEventTopic,
+		Key:  fmt.Appendf([]byte{}, "%d", protocol.MessageDeletedMsg),
+		Body: b,
+	})
+}
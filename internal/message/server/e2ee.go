@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"go-im/api/message"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+
+	"gorm.io/gorm"
+)
+
+// 加密消息包含每个设备的一份密文，限制总长度
+const maxEncryptedContent = 64 << 10
+
+// encryptedContent 加密消息内容，服务端只校验设备覆盖情况，头部其余字段和密文原样转发
+type encryptedContent struct {
+	Header struct {
+		// 发送设备
+		DeviceId string `json:"deviceId"`
+	} `json:"header"`
+	// 接收方每个设备和发送方其他设备各一份
+	Envelopes []struct {
+		UserId     int64  `json:"userId"`
+		DeviceId   string `json:"deviceId"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"envelopes"`
+}
+
+// 开关端到端加密后在会话中发出的系统消息内容
+type encryptionChangedNotice struct {
+	Event   string `json:"event"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (s *Server) SetSessionEncryption(ctx context.Context, in *message.SetSessionEncryptionReq) (*message.SetSessionEncryptionResp, error) {
+	session, err := s.userSessionRepository.FindOne(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if session.UserId != in.UserId {
+		return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+	}
+	if session.Kind != "single" {
+		return nil, errcode.ToRpcError(errcode.ErrEncryptionDenied)
+	}
+	sessionId, err := s.createSessionIfNotExists(ctx, in.UserId, session.ToId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	err = s.conversationRepository.UpdateEncrypted(ctx, in.UserId, session.ToId, in.Enabled)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	b, _ := mjson.Marshal(&encryptionChangedNotice{
+		Event:   "encryption_changed",
+		Enabled: in.Enabled,
+	})
+	err = s.postMessage(ctx, &model.Message{
+		FromId:  in.UserId,
+		ToId:    session.ToId,
+		Kind:    session.Kind,
+		Type:    model.MessageTypeSystem,
+		Content: string(b),
+	}, sessionId, nil, false)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.SetSessionEncryptionResp{}, nil
+}
+
+// checkEncryption 加密会话拒绝明文，加密消息需要覆盖双方当前全部设备
+func (s *Server) checkEncryption(ctx context.Context, in *message.SendMessageReq) error {
+	if in.Type != model.MessageTypeEncrypted {
+		conv, err := s.conversationRepository.FindByKey(ctx, "single", in.UserId, in.ToId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		if conv.Encrypted {
+			return errcode.ErrEncryptionRequired
+		}
+		return nil
+	}
+	var content encryptedContent
+	if len(in.Message) > maxEncryptedContent || mjson.Unmarshal([]byte(in.Message), &content) != nil ||
+		content.Header.DeviceId == "" {
+		return errcode.ErrInvalidParam
+	}
+	ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+	defer cancel()
+	resp, err := s.userRpc.ListDeviceIds(ctx, &user.ListDeviceIdsReq{UserIds: []int64{in.UserId, in.ToId}})
+	if err != nil {
+		return errcode.FromRpcError(err)
+	}
+	type device struct {
+		userId   int64
+		deviceId string
+	}
+	want := make(map[device]bool)
+	senderKnown := false
+	for _, item := range resp.List {
+		for _, id := range item.DeviceIds {
+			if item.UserId == in.UserId && id == content.Header.DeviceId {
+				senderKnown = true
+				continue
+			}
+			want[device{item.UserId, id}] = true
+		}
+	}
+	if !senderKnown {
+		return errcode.ErrDeviceMismatch
+	}
+	got := make(map[device]bool, len(content.Envelopes))
+	for _, env := range content.Envelopes {
+		d := device{env.UserId, env.DeviceId}
+		if env.Ciphertext == "" || got[d] {
+			return errcode.ErrInvalidParam
+		}
+		if !want[d] {
+			return errcode.ErrDeviceMismatch
+		}
+		got[d] = true
+	}
+	if len(got) != len(want) {
+		return errcode.ErrDeviceMismatch
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/access"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/protocol"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"go-im/internal/pkg/redis"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	expirerLeaseKey = "message:expirer:lease"
+	expirerLeaseTTL = 10 * time.Second
+	expirerInterval = 5 * time.Second
+	expirerBatch    = 200
+
+	maxMessageTtl = 7 * 24 * 60 * 60
+)
+
+// 修改阅后即焚设置后在会话中发出的系统消息内容
+type ttlChangedNotice struct {
+	Event string `json:"event"`
+	Ttl   int64  `json:"ttl"`
+	Mode  string `json:"mode"`
+}
+
+func expireAt(msg *model.Message) int64 {
+	if msg.ExpireAt == nil {
+		return 0
+	}
+	return msg.ExpireAt.UnixMilli()
+}
+
+func (s *Server) SetSessionTtl(ctx context.Context, in *message.SetSessionTtlReq) (*message.SetSessionTtlResp, error) {
+	mode := in.Mode
+	if mode == "" {
+		mode = model.TtlModeSent
+	}
+	if in.Ttl < 0 || in.Ttl > maxMessageTtl || (mode != model.TtlModeSent && mode != model.TtlModeRead) {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	session, err := s.userSessionRepository.FindOne(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if session.UserId != in.UserId {
+		return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+	}
+	var sessionId int64
+	if session.Kind == "group" {
+		isMember, err := s.groupMemberRepository.IsMember(ctx, session.ToId, in.UserId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if !isMember {
+			return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
+		}
+	} else {
+		sessionId, err = s.createSessionIfNotExists(ctx, in.UserId, session.ToId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+	}
+	err = s.conversationRepository.UpdateTtl(ctx, session.Kind, in.UserId, session.ToId, in.Ttl, mode)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	b, _ := mjson.Marshal(&ttlChangedNotice{
+		Event: "ttl_changed",
+		Ttl:   in.Ttl,
+		Mode:  mode,
+	})
+	err = s.postMessage(ctx, &model.Message{
+		FromId:  in.UserId,
+		ToId:    session.ToId,
+		Kind:    session.Kind,
+		Type:    model.MessageTypeSystem,
+		Content: string(b),
+	}, sessionId, nil, false)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.SetSessionTtlResp{}, nil
+}
+
+// runExpirer 只有持有租约的副本清理过期消息
+func (s *Server) runExpirer() {
+	lease := redis.NewLease(s.redis, expirerLeaseKey, expirerLeaseTTL)
+	t := time.NewTicker(expirerInterval)
+	defer t.Stop()
+	for range t.C {
+		ctx := context.Background()
+		ok, err := lease.Acquire(ctx)
+		if err != nil {
+			log.Errorf("acquire expirer lease failed, err: %v", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		s.purgeExpired(ctx)
+	}
+}
+
+func (s *Server) purgeExpired(ctx context.Context) {
+	for {
+		list, err := s.messageRepository.ListExpired(ctx, time.Now(), expirerBatch)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		if len(list) == 0 {
+			return
+		}
+		ids := make([]int64, 0, len(list))
+		for _, msg := range list {
+			ids = append(ids, msg.ID)
+		}
+		err = s.messageRepository.Purge(ctx, ids)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		if err := s.search.Delete(ctx, ids...); err != nil {
+			log.Errorf("err: %v", err)
+		}
+		// 同一批次内缓存群成员，避免每条消息都查一次
+		members := make(map[int64][]int64)
+		for _, msg := range list {
+			s.decrUnread(ctx, msg)
+			s.notifyExpired(ctx, msg, members)
+		}
+		if len(list) < expirerBatch {
+			return
+		}
+	}
+}
+
+func (s *Server) notifyExpired(ctx context.Context, msg *model.Message, members map[int64][]int64) {
+	sessionId, err := s.boxId(ctx, msg)
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	participants := []int64{msg.FromId, msg.ToId}
+	if msg.Kind == "group" {
+		ids, ok := members[msg.ToId]
+		if !ok {
+			list, err := s.groupMemberRepository.ListMember(ctx, msg.ToId)
+			if err != nil {
+				log.Errorf("err: %v", err)
+			}
+			for _, member := range list {
+				ids = append(ids, member.UserId)
+			}
+			members[msg.ToId] = ids
+		}
+		participants = ids
+	}
+	event := &access.MessageExpiredMsg{
+		MessageId: msg.ID,
+		Kind:      msg.Kind,
+		SessionId: sessionId,
+		Seq:       msg.Seq,
+		FromId:    msg.FromId,
+		PeerId:    msg.ToId,
+	}
+	for _, id := range participants {
+		if s.isUserOnline(ctx, id) {
+			event.ToId = append(event.ToId, id)
+		}
+	}
+	// 即使没人在线也要推送，接入层需要清理消息盒子
+	b, _ := mjson.Marshal(event)
+	err = s.push(ctx, protocol.PushBody{
+		Type: protocol.MessageEventTopic,
+		Key:  fmt.Appendf([]byte{}, "%d", protocol.MessageExpiredMsg),
+		Body: b,
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
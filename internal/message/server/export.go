@@ -0,0 +1,391 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/message/model"
+	"go-im/internal/message/pkg/export"
+	"go-im/internal/message/repository"
+	"go-im/internal/pkg/log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	exporterInterval = 2 * time.Second
+	exporterBatch    = 10
+	exportPageSize   = 500
+	exportChunkSize  = 32 << 10
+	// 存库的块大小，下载时再按 exportChunkSize 分片发送
+	exportStoreSize  = 256 << 10
+	exportChunkBatch = 8
+	// 处理中超过该时间没有进度视为进程中途退出
+	exportStuckTimeout     = 2 * time.Minute
+	exportCleanupInterval  = 10 * time.Minute
+	defaultExportRetention = 24 * time.Hour
+)
+
+func (s *Server) CreateExport(ctx context.Context, in *message.CreateExportReq) (*message.CreateExportResp, error) {
+	if !export.ValidFormat(in.Format) || in.StartTime < 0 || in.EndTime < 0 ||
+		(in.EndTime > 0 && in.EndTime <= in.StartTime) {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	session, err := s.userSessionRepository.FindOne(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if !in.Compliance && session.UserId != in.UserId {
+		return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+	}
+	// 退群后会话还在，不能导出之后的消息
+	if !in.Compliance && session.Kind == "group" {
+		isMember, err := s.groupMemberRepository.IsMember(ctx, session.ToId, session.UserId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if !isMember {
+			return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
+		}
+	}
+	job := &model.ExportJob{
+		UserId:     in.UserId,
+		SessionId:  session.ID,
+		Kind:       session.Kind,
+		OwnerId:    session.UserId,
+		ToId:       session.ToId,
+		Format:     in.Format,
+		Compliance: in.Compliance,
+		Status:     model.ExportStatusPending,
+	}
+	if in.StartTime > 0 {
+		t := time.UnixMilli(in.StartTime)
+		job.StartTime = &t
+	}
+	if in.EndTime > 0 {
+		t := time.UnixMilli(in.EndTime)
+		job.EndTime = &t
+	}
+	id, err := s.exportJobRepository.Create(ctx, job)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.CreateExportResp{
+		Id: id,
+	}, nil
+}
+
+func (s *Server) GetExport(ctx context.Context, in *message.GetExportReq) (*message.GetExportResp, error) {
+	job, err := s.findExport(ctx, in.UserId, in.Id)
+	if err != nil {
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.GetExportResp{
+		Id:           job.ID,
+		SessionId:    job.SessionId,
+		Format:       job.Format,
+		Status:       job.Status,
+		MessageCount: job.MessageCount,
+		FileSize:     job.FileSize,
+		Error:        job.Error,
+		CreateTime:   job.CreatedAt.UnixMilli(),
+	}, nil
+}
+
+// DownloadExport 按序读取库中的文件块，任意副本都可以处理
+func (s *Server) DownloadExport(in *message.DownloadExportReq, stream message.Message_DownloadExportServer) error {
+	ctx := stream.Context()
+	job, err := s.findExport(ctx, in.UserId, in.Id)
+	if err != nil {
+		return errcode.ToRpcError(err)
+	}
+	if job.Status != model.ExportStatusDone {
+		return errcode.ToRpcError(errcode.ErrExportNotReady)
+	}
+	var idx int
+	for {
+		list, err := s.exportJobRepository.ListChunk(ctx, job.ID, idx, exportChunkBatch)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return errcode.ToRpcError(err)
+		}
+		for _, chunk := range list {
+			for data := chunk.Data; len(data) > 0; {
+				n := min(len(data), exportChunkSize)
+				if err := stream.Send(&message.ExportChunk{Data: data[:n]}); err != nil {
+					return err
+				}
+				data = data[n:]
+			}
+			idx = chunk.Idx
+		}
+		if len(list) < exportChunkBatch {
+			return nil
+		}
+	}
+}
+
+func (s *Server) findExport(ctx context.Context, userId int64, id int64) (*model.ExportJob, error) {
+	job, err := s.exportJobRepository.FindOne(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ErrExportNotExists
+		}
+		log.Errorf("err: %v", err)
+		return nil, err
+	}
+	if job.UserId != userId {
+		return nil, errcode.ErrExportNotExists
+	}
+	return job, nil
+}
+
+// runExporter 各副本抢占待处理的任务，同时清理过期文件
+func (s *Server) runExporter() {
+	t := time.NewTicker(exporterInterval)
+	defer t.Stop()
+	var lastCleanup time.Time
+	for range t.C {
+		ctx := context.Background()
+		if err := s.exportJobRepository.ResetStuck(ctx, time.Now().Add(-exportStuckTimeout)); err != nil {
+			log.Errorf("err: %v", err)
+		}
+		list, err := s.exportJobRepository.ListPending(ctx, exporterBatch)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			continue
+		}
+		for _, job := range list {
+			s.dispatchExport(ctx, job)
+		}
+		if time.Since(lastCleanup) >= exportCleanupInterval {
+			lastCleanup = time.Now()
+			s.cleanupExports(ctx)
+		}
+	}
+}
+
+func (s *Server) dispatchExport(ctx context.Context, job *model.ExportJob) {
+	ok, err := s.exportJobRepository.Claim(ctx, job.ID)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	status, errMsg := model.ExportStatusDone, ""
+	count, size, err := s.writeExport(ctx, job)
+	if err != nil {
+		log.Errorf("export %d failed, err: %v", job.ID, err)
+		status, errMsg = model.ExportStatusFailed, err.Error()
+	}
+	if err := s.exportJobRepository.Finish(ctx, job.ID, status, count, size, errMsg); err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// writeExport 按 seq 分页读取消息，按块写入库中，返回消息数和文件大小
+func (s *Server) writeExport(ctx context.Context, job *model.ExportJob) (count int64, size int64, err error) {
+	var seq int64
+	if !job.Compliance {
+		session, err := s.userSessionRepository.FindOne(ctx, job.SessionId)
+		if err != nil {
+			return 0, 0, err
+		}
+		seq = session.ClearedSeq
+		// 排队期间可能已经退群
+		if job.Kind == "group" {
+			isMember, err := s.groupMemberRepository.IsMember(ctx, job.ToId, job.OwnerId)
+			if err != nil {
+				return 0, 0, err
+			}
+			if !isMember {
+				return 0, 0, errcode.ErrNotGroupMember
+			}
+		}
+	}
+	title, err := s.exportTitle(ctx, job)
+	if err != nil {
+		return 0, 0, err
+	}
+	// 清掉中途退出时写入的块
+	if err := s.exportJobRepository.DeleteChunks(ctx, []int64{job.ID}); err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err != nil {
+			if err := s.exportJobRepository.DeleteChunks(ctx, []int64{job.ID}); err != nil {
+				log.Errorf("err: %v", err)
+			}
+		}
+	}()
+	cw := &chunkWriter{ctx: ctx, repo: s.exportJobRepository, jobId: job.ID}
+	w, err := export.NewWriter(job.Format, cw, title)
+	if err != nil {
+		return 0, 0, err
+	}
+	names := make(map[int64]string)
+	for {
+		list, err := s.messageRepository.ListExport(ctx, job.Kind, job.OwnerId, job.ToId, seq, job.StartTime, job.EndTime, job.Compliance, exportPageSize)
+		if err != nil {
+			return count, 0, err
+		}
+		if len(list) == 0 {
+			break
+		}
+		seq = list[len(list)-1].Seq
+		full := len(list) == exportPageSize
+		if job.Kind == "group" && !job.Compliance {
+			list, err = s.filterHidden(ctx, job.OwnerId, list)
+			if err != nil {
+				return count, 0, err
+			}
+		}
+		if err := s.fillNames(ctx, names, list); err != nil {
+			return count, 0, err
+		}
+		for _, item := range list {
+			err := w.Write(&export.Record{
+				Id:       item.ID,
+				Seq:      item.Seq,
+				FromId:   item.FromId,
+				FromName: names[item.FromId],
+				Type:     item.Type,
+				Content:  item.Content,
+				SendTime: item.CreatedAt,
+				Revoked:  item.RevokedAt != nil,
+			})
+			if err != nil {
+				return count, 0, err
+			}
+		}
+		count += int64(len(list))
+		if !full {
+			break
+		}
+		if err := s.exportJobRepository.Progress(ctx, job.ID, count); err != nil {
+			log.Errorf("err: %v", err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		return count, 0, err
+	}
+	if err = cw.Flush(); err != nil {
+		return count, 0, err
+	}
+	return count, cw.size, nil
+}
+
+// chunkWriter 把导出内容切成固定大小的块写库
+type chunkWriter struct {
+	ctx   context.Context
+	repo  *repository.ExportJobRepository
+	jobId int64
+	idx   int
+	buf   []byte
+	size  int64
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		m := min(len(p), exportStoreSize-len(w.buf))
+		w.buf = append(w.buf, p[:m]...)
+		p = p[m:]
+		if len(w.buf) == exportStoreSize {
+			if err := w.Flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.repo.AddChunk(w.ctx, &model.ExportChunk{
+		JobId: w.jobId,
+		Idx:   w.idx + 1,
+		Data:  w.buf,
+	})
+	if err != nil {
+		return err
+	}
+	w.idx++
+	w.size += int64(len(w.buf))
+	w.buf = nil
+	return nil
+}
+
+// fillNames 查询还没有缓存的发送者名字
+func (s *Server) fillNames(ctx context.Context, names map[int64]string, list []*model.Message) error {
+	var ids []int64
+	for _, item := range list {
+		if _, ok := names[item.FromId]; !ok {
+			ids = append(ids, item.FromId)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	users, err := s.userInfos(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for id, info := range users {
+		names[id] = info.Username
+	}
+	return nil
+}
+
+func (s *Server) exportTitle(ctx context.Context, job *model.ExportJob) (string, error) {
+	if job.Kind == "group" {
+		group, err := s.groupRepository.FindOne(ctx, job.ToId)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("群聊「%s」的聊天记录", group.Name), nil
+	}
+	users, err := s.userInfos(ctx, []int64{job.OwnerId, job.ToId})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s 与 %s 的聊天记录", users[job.OwnerId].Username, users[job.ToId].Username), nil
+}
+
+func (s *Server) cleanupExports(ctx context.Context) {
+	for {
+		list, err := s.exportJobRepository.ListExpired(ctx, time.Now().Add(-s.exportRetention), exporterBatch)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		if len(list) == 0 {
+			return
+		}
+		ids := make([]int64, 0, len(list))
+		for _, job := range list {
+			ids = append(ids, job.ID)
+		}
+		if err := s.exportJobRepository.DeleteChunks(ctx, ids); err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		if err := s.exportJobRepository.MarkExpired(ctx, ids); err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+	}
+}
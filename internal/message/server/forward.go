@@ -0,0 +1,305 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxForwardTargets = 20
+	// 逐条转发每条都要落库推送，条数限制更严
+	maxForwardSingle = 30
+	maxForwardMerged = 100
+
+	mergedPreviewLines  = 3
+	mergedPreviewLength = 30
+)
+
+func (s *Server) ForwardMessage(ctx context.Context, in *message.ForwardMessageReq) (*message.ForwardMessageResp, error) {
+	limit := maxForwardSingle
+	if in.Merged {
+		limit = maxForwardMerged
+	}
+	if len(in.MessageIds) == 0 || len(in.MessageIds) > limit || len(in.Targets) == 0 || len(in.Targets) > maxForwardTargets {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	msgs, err := s.readableMessages(ctx, in.UserId, in.MessageIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	for _, msg := range msgs {
+		// 阅后即焚、系统消息、卡片和密文不允许转发，合并转发不支持嵌套
+		if msg.Ttl > 0 || msg.Type == model.MessageTypeSystem || msg.Type == model.MessageTypeCard ||
+			msg.Type == model.MessageTypeEncrypted ||
+			(in.Merged && msg.Type == model.MessageTypeMerged) {
+			return nil, errcode.ToRpcError(errcode.ErrForwardDenied)
+		}
+	}
+	if in.Merged {
+		card, err := s.createMergedCard(ctx, in.UserId, in.Title, msgs)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		msgs = []*model.Message{card}
+	}
+
+	resp := &message.ForwardMessageResp{
+		List: make([]*message.ForwardResult, 0, len(in.Targets)),
+	}
+	for _, target := range in.Targets {
+		result := &message.ForwardResult{
+			Kind: target.Kind,
+			ToId: target.ToId,
+		}
+		// 单个目标失败不影响其他目标
+		result.MessageIds, err = s.forwardTo(ctx, in.UserId, target, msgs)
+		if err != nil {
+			log.Errorf("forward to %s-%d failed, err: %v", target.Kind, target.ToId, err)
+			var e *errcode.Error
+			if !errors.As(err, &e) {
+				e = errcode.ErrServerInternalError
+			}
+			result.Code, result.Error = int64(e.Code), e.Message
+		}
+		resp.List = append(resp.List, result)
+	}
+	return resp, nil
+}
+
+// readableMessages 校验调用者能看到全部消息，返回按 seq 排序的结果
+func (s *Server) readableMessages(ctx context.Context, userId int64, ids []int64) ([]*model.Message, error) {
+	msgs, err := s.messageRepository.ListByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) != len(slices.Compact(slices.Sorted(slices.Values(ids)))) {
+		return nil, errcode.ErrMessageNotExists
+	}
+	first := msgs[0]
+	a, b := model.ConversationKey(first.Kind, first.FromId, first.ToId)
+	for _, msg := range msgs[1:] {
+		if msg.Kind != first.Kind {
+			return nil, errcode.ErrInvalidParam
+		}
+		if x, y := model.ConversationKey(msg.Kind, msg.FromId, msg.ToId); x != a || y != b {
+			return nil, errcode.ErrInvalidParam
+		}
+	}
+	peerId := first.ToId
+	if first.Kind == "group" {
+		isMember, err := s.groupMemberRepository.IsMember(ctx, first.ToId, userId)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, errcode.ErrNotGroupMember
+		}
+	} else {
+		if a != userId && b != userId {
+			return nil, errcode.ErrMessageNotExists
+		}
+		peerId = a + b - userId
+	}
+	var clearedSeq int64
+	session, err := s.userSessionRepository.FindByPeer(ctx, userId, peerId, first.Kind)
+	if err == nil {
+		clearedSeq = session.ClearedSeq
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	deleted, err := s.deletionRepository.ListDeleted(ctx, userId, ids)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, msg := range msgs {
+		_, isDeleted := deleted[msg.ID]
+		if isDeleted || msg.Seq <= clearedSeq || msg.RevokedAt != nil ||
+			(msg.ExpireAt != nil && !msg.ExpireAt.After(now)) {
+			return nil, errcode.ErrMessageNotExists
+		}
+	}
+	slices.SortFunc(msgs, func(x, y *model.Message) int {
+		return cmp.Compare(x.Seq, y.Seq)
+	})
+	return msgs, nil
+}
+
+// createMergedCard 保存聊天记录快照，返回待发送的卡片消息
+func (s *Server) createMergedCard(ctx context.Context, userId int64, title string, msgs []*model.Message) (*model.Message, error) {
+	userIds := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		userIds = append(userIds, msg.FromId)
+	}
+	users, err := s.userInfos(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*model.ForwardItem, 0, len(msgs))
+	for _, msg := range msgs {
+		info := users[msg.FromId]
+		items = append(items, &model.ForwardItem{
+			MessageId:  msg.ID,
+			FromId:     msg.FromId,
+			FromName:   info.Username,
+			FromAvatar: info.Avatar,
+			Type:       msg.Type,
+			Content:    msg.Content,
+			SendTime:   msg.CreatedAt.UnixMilli(),
+		})
+	}
+	b, _ := mjson.Marshal(items)
+	bundleId, err := s.forwardBundleRepository.Create(ctx, &model.ForwardBundle{
+		UserId: userId,
+		Title:  title,
+		Items:  string(b),
+	})
+	if err != nil {
+		return nil, err
+	}
+	card := &model.MergedCard{
+		BundleId: bundleId,
+		Title:    title,
+		Count:    len(items),
+		Preview:  make([]string, 0, mergedPreviewLines),
+	}
+	for _, item := range items[:min(len(items), mergedPreviewLines)] {
+		content := item.Content
+		if item.Type != "text" {
+			content = fmt.Sprintf("[%s]", item.Type)
+		}
+		runes := []rune(content)
+		if len(runes) > mergedPreviewLength {
+			content = string(runes[:mergedPreviewLength]) + "..."
+		}
+		card.Preview = append(card.Preview, item.FromName+": "+content)
+	}
+	b, _ = mjson.Marshal(card)
+	return &model.Message{
+		Type:    model.MessageTypeMerged,
+		Content: string(b),
+	}, nil
+}
+
+// forwardTo 校验调用者能在目标会话发言后逐条复制消息
+func (s *Server) forwardTo(ctx context.Context, userId int64, target *message.ForwardTarget, msgs []*model.Message) ([]int64, error) {
+	var sessionId int64
+	var stranger bool
+	ids := make([]int64, 0, len(msgs))
+	switch target.Kind {
+	case "group":
+		isMember, err := s.groupMemberRepository.IsMember(ctx, target.ToId, userId)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, errcode.ErrNotGroupMember
+		}
+	case "single":
+		if target.ToId == userId {
+			return nil, errcode.ErrInvalidParam
+		}
+		if err := s.checkBlocked(ctx, userId, target.ToId); err != nil {
+			return nil, err
+		}
+		var err error
+		// 整批计入限额，超出时整批拒绝，不会只发出一部分
+		stranger, err = s.checkStranger(ctx, userId, target.ToId, len(msgs))
+		if err != nil {
+			return nil, err
+		}
+		if stranger {
+			// 未发出的部分归还限额
+			defer func() {
+				if n := len(msgs) - len(ids); n > 0 {
+					s.releaseStranger(ctx, userId, target.ToId, n)
+				}
+			}()
+		}
+		// 转发的都是明文，目标会话开启加密时拒绝
+		if err := s.checkEncryption(ctx, &message.SendMessageReq{UserId: userId, ToId: target.ToId}); err != nil {
+			return nil, err
+		}
+		sessionId, err = s.createSessionIfNotExists(ctx, userId, target.ToId)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errcode.ErrInvalidParam
+	}
+	for _, msg := range msgs {
+		copied := &model.Message{
+			FromId:  userId,
+			ToId:    target.ToId,
+			Kind:    target.Kind,
+			Type:    msg.Type,
+			Content: msg.Content,
+		}
+		if err := s.postMessage(ctx, copied, sessionId, nil, false); err != nil {
+			return ids, err
+		}
+		ids = append(ids, copied.ID)
+	}
+	if target.Kind == "single" {
+		s.recordSingleMessage(ctx, userId, target.ToId)
+	}
+	return ids, nil
+}
+
+func (s *Server) GetMergedForward(ctx context.Context, in *message.GetMergedForwardReq) (*message.GetMergedForwardResp, error) {
+	msgs, err := s.readableMessages(ctx, in.UserId, []int64{in.MessageId})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if msgs[0].Type != model.MessageTypeMerged {
+		return nil, errcode.ToRpcError(errcode.ErrMessageNotExists)
+	}
+	var card model.MergedCard
+	if err := mjson.Unmarshal([]byte(msgs[0].Content), &card); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(errcode.ErrMessageNotExists)
+	}
+	bundle, err := s.forwardBundleRepository.FindOne(ctx, card.BundleId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrMessageNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	var items []*model.ForwardItem
+	if err := mjson.Unmarshal([]byte(bundle.Items), &items); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &message.GetMergedForwardResp{
+		Title: bundle.Title,
+		List:  make([]*message.ForwardedItem, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.List = append(resp.List, &message.ForwardedItem{
+			MessageId:  item.MessageId,
+			FromId:     item.FromId,
+			FromName:   item.FromName,
+			FromAvatar: item.FromAvatar,
+			Type:       item.Type,
+			Content:    item.Content,
+			SendTime:   item.SendTime,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,365 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/user"
+	"go-im/internal/common/types"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/push"
+	"go-im/internal/pkg/utils"
+	"slices"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	pushQueueSize         = 2000
+	pushWorkers           = 4
+	defaultCollapseWindow = 30 * time.Second
+	// 合并窗口过期后再汇总，过期后到达的消息会开启新窗口而不是计入旧窗口
+	pushFlushDelay = 200 * time.Millisecond
+)
+
+type pushTask struct {
+	msg        *model.Message
+	mentions   []int64
+	mentionAll bool
+	hiddenFor  []int64
+}
+
+// pushContent 按 full 模式生成的通知内容，其他模式在发送时裁剪
+type pushContent struct {
+	Kind string
+	// 单聊为发送者，群聊为群ID
+	PeerId int64
+	Title  string
+	Sender string
+	Body   string
+}
+
+func (s *Server) initPush(cfg push.Config) {
+	provider, err := push.New(cfg)
+	if err != nil {
+		panic(err)
+	}
+	s.pusher = provider
+	s.pushWindow = time.Duration(cfg.CollapseWindow) * time.Second
+	if s.pushWindow <= 0 {
+		s.pushWindow = defaultCollapseWindow
+	}
+	s.pushCh = make(chan *pushTask, pushQueueSize)
+	for range pushWorkers {
+		utils.SafeGo(func() {
+			s.runPusher()
+		})
+	}
+}
+
+// pushOffline 离线推送不阻塞发消息，队列满时丢弃并记录日志
+func (s *Server) pushOffline(msg *model.Message, mentions []int64, mentionAll bool, hiddenFor []int64) {
+	if msg.Type == model.MessageTypeSystem {
+		return
+	}
+	select {
+	case s.pushCh <- &pushTask{msg: msg, mentions: mentions, mentionAll: mentionAll, hiddenFor: hiddenFor}:
+	default:
+		log.Errorf("push queue full, drop message %d", msg.ID)
+	}
+}
+
+func (s *Server) runPusher() {
+	for task := range s.pushCh {
+		s.dispatchPush(context.Background(), task)
+	}
+}
+
+func (s *Server) dispatchPush(ctx context.Context, task *pushTask) {
+	msg := task.msg
+	recipients, err := s.pushRecipients(ctx, task)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+	content, err := s.pushContent(ctx, msg)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	// 窗口内已推送过的接收者只计数，窗口结束时汇总成一条
+	pipe := s.redis.Pipeline()
+	opens := make([]*goredis.BoolCmd, 0, len(recipients))
+	for _, id := range recipients {
+		opens = append(opens, pipe.SetNX(ctx, fmt.Sprintf(types.CachePushWindowKey, id, content.Kind, content.PeerId), 1, s.pushWindow))
+	}
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline open push window", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	opened := make([]int64, 0, len(recipients))
+	pipe = s.redis.Pipeline()
+	for i, id := range recipients {
+		if opens[i].Val() {
+			opened = append(opened, id)
+			continue
+		}
+		key := fmt.Sprintf(types.CachePushPendingKey, id, content.Kind, content.PeerId)
+		pipe.HIncrBy(ctx, key, "count", 1)
+		pipe.HSet(ctx, key, "title", content.Title, "sender", content.Sender, "body", content.Body)
+		pipe.Expire(ctx, key, 2*s.pushWindow)
+	}
+	if len(opened) < len(recipients) {
+		_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+			cmds, err := pipe.Exec(ctx)
+			return cmds, "pipeline add push pending", err
+		})
+		if err != nil {
+			log.Errorf("err: %v", err)
+		}
+	}
+	if len(opened) == 0 {
+		return
+	}
+	s.sendPush(ctx, opened, content, 1)
+	for _, id := range opened {
+		s.schedulePushFlush(id, content.Kind, content.PeerId)
+	}
+}
+
+// pushRecipients 不在线、未免打扰的接收者，群里被@的成员忽略免打扰
+func (s *Server) pushRecipients(ctx context.Context, task *pushTask) ([]int64, error) {
+	msg := task.msg
+	if msg.Kind == "single" {
+		if s.isUserOnline(ctx, msg.ToId) {
+			return nil, nil
+		}
+		session, err := s.userSessionRepository.FindByPeer(ctx, msg.ToId, msg.FromId, "single")
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if session != nil && session.MutedAt != nil {
+			return nil, nil
+		}
+		return []int64{msg.ToId}, nil
+	}
+	members, err := s.groupMemberRepository.ListMember(ctx, msg.ToId)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		if member.UserId != msg.FromId && !slices.Contains(task.hiddenFor, member.UserId) {
+			ids = append(ids, member.UserId)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	online := make(map[int64]bool)
+	for _, id := range s.onlineUsers(ctx, ids) {
+		online[id] = true
+	}
+	ids = slices.DeleteFunc(ids, func(id int64) bool {
+		return online[id]
+	})
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if task.mentionAll {
+		return ids, nil
+	}
+	sessions, err := s.userSessionRepository.ListGroupSession(ctx, msg.ToId)
+	if err != nil {
+		return nil, err
+	}
+	muted := make(map[int64]bool, len(sessions))
+	for _, session := range sessions {
+		if session.MutedAt != nil {
+			muted[session.UserId] = true
+		}
+	}
+	resp := ids[:0]
+	for _, id := range ids {
+		if !muted[id] || slices.Contains(task.mentions, id) {
+			resp = append(resp, id)
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) pushContent(ctx context.Context, msg *model.Message) (*pushContent, error) {
+	users, err := s.userInfos(ctx, []int64{msg.FromId})
+	if err != nil {
+		return nil, err
+	}
+	sender := users[msg.FromId].Username
+	content := &pushContent{
+		Kind:   msg.Kind,
+		PeerId: msg.FromId,
+		Title:  sender,
+		Sender: sender,
+		Body:   pushExcerpt(msg),
+	}
+	if msg.Kind == "group" {
+		group, err := s.groupRepository.FindOne(ctx, msg.ToId)
+		if err != nil {
+			return nil, err
+		}
+		content.PeerId, content.Title = msg.ToId, group.Name
+		content.Body = sender + ": " + content.Body
+	}
+	return content, nil
+}
+
+// pushExcerpt 非文本消息和阅后即焚消息只显示类型
+func pushExcerpt(msg *model.Message) string {
+	switch {
+	case msg.Ttl > 0:
+		return "[阅后即焚]"
+	case isTextType(msg.Type):
+		return excerpt(msg.Content)
+	case msg.Type == "image":
+		return "[图片]"
+	case msg.Type == model.MessageTypeEncrypted:
+		return "[加密消息]"
+	case msg.Type == model.MessageTypeCard:
+		return "[卡片]"
+	case msg.Type == model.MessageTypeMerged:
+		return "[聊天记录]"
+	}
+	return "[消息]"
+}
+
+// notificationText 按用户的预览设置生成标题和正文，count 大于 1 时为合并后的汇总
+func notificationText(preview string, c *pushContent, count int64) (string, string) {
+	switch preview {
+	case push.PreviewHidden:
+		if count > 1 {
+			return "新消息", fmt.Sprintf("你收到%d条新消息", count)
+		}
+		return "新消息", "你收到一条新消息"
+	case push.PreviewSender:
+		if count > 1 {
+			return c.Title, fmt.Sprintf("%d条新消息", count)
+		}
+		if c.Kind == "group" {
+			return c.Title, c.Sender + "发来一条新消息"
+		}
+		return c.Title, "发来一条新消息"
+	}
+	if count > 1 {
+		return c.Title, fmt.Sprintf("[%d条] %s", count, c.Body)
+	}
+	return c.Title, c.Body
+}
+
+// sendPush 跳过免打扰时段内的用户，并清理推送通道返回失效的令牌
+func (s *Server) sendPush(ctx context.Context, userIds []int64, content *pushContent, count int64) {
+	rctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+	resp, err := s.userRpc.GetPushTargets(rctx, &user.GetPushTargetsReq{
+		UserIds: userIds,
+	})
+	cancel()
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	now := time.Now()
+	var invalid []string
+	for _, target := range resp.List {
+		quiet, err := push.ParseQuietHours(target.Setting.QuietHours)
+		if err != nil {
+			log.Errorf("err: %v", err)
+		}
+		if quiet.Contains(now, int(target.Setting.TzOffset)) {
+			continue
+		}
+		title, body := notificationText(target.Setting.Preview, content, count)
+		for _, device := range target.Devices {
+			err := s.pusher.Send(ctx, &push.Notification{
+				Platform:    device.Platform,
+				Token:       device.Token,
+				Title:       title,
+				Body:        body,
+				CollapseKey: fmt.Sprintf("%s:%d", content.Kind, content.PeerId),
+				Data: map[string]string{
+					"kind":   content.Kind,
+					"peerId": strconv.FormatInt(content.PeerId, 10),
+				},
+			})
+			if errors.Is(err, push.ErrInvalidToken) {
+				invalid = append(invalid, device.Token)
+				continue
+			}
+			if err != nil {
+				log.Errorf("push to %d via %s failed, err: %v", target.UserId, s.pusher.Name(), err)
+			}
+		}
+	}
+	if len(invalid) == 0 {
+		return
+	}
+	rctx, cancel = context.WithTimeout(ctx, userRpcTimeout)
+	defer cancel()
+	if _, err := s.userRpc.InvalidatePushTokens(rctx, &user.InvalidatePushTokensReq{Tokens: invalid}); err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// schedulePushFlush 由开启窗口的副本负责汇总，副本中途退出时计数随过期时间丢弃
+func (s *Server) schedulePushFlush(userId int64, kind string, peerId int64) {
+	time.AfterFunc(s.pushWindow+pushFlushDelay, func() {
+		utils.SafeGo(func() {
+			s.flushPush(context.Background(), userId, kind, peerId)
+		})
+	})
+}
+
+func (s *Server) flushPush(ctx context.Context, userId int64, kind string, peerId int64) {
+	key := fmt.Sprintf(types.CachePushPendingKey, userId, kind, peerId)
+	pipe := s.redis.TxPipeline()
+	get := pipe.HGetAll(ctx, key)
+	pipe.Del(ctx, key)
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline take push pending", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	pending := get.Val()
+	count, _ := strconv.ParseInt(pending["count"], 10, 64)
+	// 用户已上线时直接在客户端看到未读
+	if count == 0 || s.isUserOnline(ctx, userId) {
+		return
+	}
+	// 汇总后重新开启窗口，持续活跃的会话每个窗口最多推送一次
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Set(ctx, fmt.Sprintf(types.CachePushWindowKey, userId, kind, peerId), 1, s.pushWindow)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	s.sendPush(ctx, []int64{userId}, &pushContent{
+		Kind:   kind,
+		PeerId: peerId,
+		Title:  pending["title"],
+		Sender: pending["sender"],
+		Body:   pending["body"],
+	}, count)
+	s.schedulePushFlush(userId, kind, peerId)
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"go-im/api/access"
+	"go-im/internal/common/protocol"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"go-im/internal/pkg/utils"
+	"sync"
+	"time"
+)
+
+const maxReadersPerReceipt = 500
+
+type receiptKey struct {
+	kind string
+	// single 为阅读者的会话ID，group 为群ID
+	id int64
+}
+
+type receipt struct {
+	toId    int64
+	readers map[int64]int64
+}
+
+// 已读回执按会话聚合，每个周期每个会话最多推送一次，避免大群刷屏接入层
+type receiptBatcher struct {
+	m        sync.Mutex
+	pending  map[receiptKey]*receipt
+	interval time.Duration
+	flush    func(key receiptKey, r *receipt)
+}
+
+func newReceiptBatcher(interval time.Duration, flush func(key receiptKey, r *receipt)) *receiptBatcher {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	b := &receiptBatcher{
+		pending:  make(map[receiptKey]*receipt, 100),
+		interval: interval,
+		flush:    flush,
+	}
+	utils.SafeGo(func() {
+		b.run()
+	})
+	return b
+}
+
+func (b *receiptBatcher) Add(key receiptKey, toId int64, userId int64, seq int64) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	r, ok := b.pending[key]
+	if !ok {
+		r = &receipt{
+			toId:    toId,
+			readers: make(map[int64]int64),
+		}
+		b.pending[key] = r
+	}
+	if r.readers[userId] < seq {
+		r.readers[userId] = seq
+	}
+}
+
+func (b *receiptBatcher) run() {
+	t := time.NewTicker(b.interval)
+	defer t.Stop()
+	for range t.C {
+		b.m.Lock()
+		if len(b.pending) == 0 {
+			b.m.Unlock()
+			continue
+		}
+		pending := b.pending
+		b.pending = make(map[receiptKey]*receipt, len(pending))
+		b.m.Unlock()
+		for key, r := range pending {
+			b.flush(key, r)
+		}
+	}
+}
+
+func (s *Server) flushReceipt(key receiptKey, r *receipt) {
+	ctx := context.Background()
+	readers := make([]*access.ReadCursor, 0, len(r.readers))
+	for userId, seq := range r.readers {
+		readers = append(readers, &access.ReadCursor{UserId: userId, Seq: seq})
+	}
+	msg := access.ReadReceiptMsg{
+		Kind: key.kind,
+	}
+	switch key.kind {
+	case "single":
+		if !s.isUserOnline(ctx, r.toId) {
+			return
+		}
+		// 单聊的 key 是阅读者的会话，只有一个阅读者；推送时换成发送方自己的会话ID
+		session, err := s.userSessionRepository.GetUserSession(ctx, r.toId, readers[0].UserId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		msg.SessionId = session.ID
+		msg.ToId = []int64{r.toId}
+	case "group":
+		members, err := s.groupMemberRepository.ListMember(ctx, key.id)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		onlineUser := make([]int64, 0)
+		for _, member := range members {
+			if s.isUserOnline(ctx, member.UserId) {
+				onlineUser = append(onlineUser, member.UserId)
+			}
+		}
+		if len(onlineUser) == 0 {
+			return
+		}
+		msg.GroupId = key.id
+		msg.ToId = onlineUser
+	default:
+		return
+	}
+	for i := 0; i < len(readers); i += maxReadersPerReceipt {
+		end := min(i+maxReadersPerReceipt, len(readers))
+		msg.Readers = readers[i:end]
+		b, _ := mjson.Marshal(&msg)
+		err := s.push(ctx, protocol.PushBody{
+			Type: protocol.MessageEventTopic,
+			Key:  fmt.Appendf([]byte{}, "%d", protocol.ReadReceiptMsg),
+			Body: b,
+		})
+		if err != nil {
+			log.Errorf("err: %v", err)
+		}
+	}
+}
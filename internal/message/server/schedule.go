@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"go-im/internal/pkg/moderation"
+	"go-im/internal/pkg/redis"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	schedulerLeaseKey = "message:scheduler:lease"
+	schedulerLeaseTTL = 10 * time.Second
+	schedulerInterval = time.Second
+	schedulerBatch    = 100
+
+	// 发送中超过该时间仍未完成视为进程中途退出
+	scheduleStuckTimeout = time.Minute
+	maxScheduleAhead     = 365 * 24 * time.Hour
+)
+
+func (s *Server) ScheduleMessage(ctx context.Context, in *message.ScheduleMessageReq) (*message.ScheduleMessageResp, error) {
+	sendAt := time.UnixMilli(in.SendAt)
+	if err := checkSendAt(sendAt); err != nil {
+		return nil, errcode.ToRpcError(err)
+	}
+	// 卡片需要机器人实时响应回调，加密消息的设备列表在发送时才能确定，都不支持定时发送
+	if in.Type == model.MessageTypeSystem || in.Type == model.MessageTypeMerged || in.Type == model.MessageTypeCard ||
+		in.Type == model.MessageTypeEncrypted {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	if isTextType(in.Type) {
+		content, err := s.moderator.Check(ctx, moderation.SceneMessage, in.UserId, in.Message)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		in.Message = content
+	}
+	if in.Kind == "group" {
+		isMember, err := s.groupMemberRepository.IsMember(ctx, in.ToId, in.UserId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if !isMember {
+			return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
+		}
+	} else if in.Kind != "single" {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	mentions := ""
+	if len(in.Mentions) > 0 {
+		b, _ := mjson.Marshal(in.Mentions)
+		mentions = string(b)
+	}
+	id, err := s.scheduledRepository.Create(ctx, &model.ScheduledMessage{
+		UserId:     in.UserId,
+		ToId:       in.ToId,
+		Kind:       in.Kind,
+		Type:       in.Type,
+		Content:    in.Message,
+		Mentions:   mentions,
+		MentionAll: in.MentionAll,
+		SendAt:     sendAt,
+		Status:     model.ScheduleStatusPending,
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.ScheduleMessageResp{Id: id}, nil
+}
+
+func (s *Server) ListScheduledMessage(ctx context.Context, in *message.ListScheduledMessageReq) (*message.ListScheduledMessageResp, error) {
+	status := []string{model.ScheduleStatusPending, model.ScheduleStatusSending, model.ScheduleStatusFailed}
+	list, err := s.scheduledRepository.ListByUser(ctx, in.UserId, status)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &message.ListScheduledMessageResp{
+		List: make([]*message.ScheduledMessageInfo, 0, len(list)),
+	}
+	for _, item := range list {
+		var mentions []int64
+		if item.Mentions != "" {
+			_ = mjson.Unmarshal([]byte(item.Mentions), &mentions)
+		}
+		resp.List = append(resp.List, &message.ScheduledMessageInfo{
+			Id:         item.ID,
+			Kind:       item.Kind,
+			ToId:       item.ToId,
+			Type:       item.Type,
+			Message:    item.Content,
+			Mentions:   mentions,
+			MentionAll: item.MentionAll,
+			SendAt:     item.SendAt.UnixMilli(),
+			Status:     item.Status,
+			Error:      item.Error,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) CancelScheduledMessage(ctx context.Context, in *message.CancelScheduledMessageReq) (*message.CancelScheduledMessageResp, error) {
+	err := s.updateScheduled(ctx, in.Id, in.UserId, map[string]any{
+		"status": model.ScheduleStatusCanceled,
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.CancelScheduledMessageResp{}, nil
+}
+
+func (s *Server) RescheduleMessage(ctx context.Context, in *message.RescheduleMessageReq) (*message.RescheduleMessageResp, error) {
+	sendAt := time.UnixMilli(in.SendAt)
+	if err := checkSendAt(sendAt); err != nil {
+		return nil, errcode.ToRpcError(err)
+	}
+	err := s.updateScheduled(ctx, in.Id, in.UserId, map[string]any{
+		"send_at": sendAt,
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.RescheduleMessageResp{}, nil
+}
+
+func checkSendAt(sendAt time.Time) error {
+	now := time.Now()
+	if !sendAt.After(now) || sendAt.Sub(now) > maxScheduleAhead {
+		return errcode.ErrScheduleTimeInvalid
+	}
+	return nil
+}
+
+func (s *Server) updateScheduled(ctx context.Context, id int64, userId int64, values map[string]any) error {
+	ok, err := s.scheduledRepository.UpdatePending(ctx, id, userId, values)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	item, err := s.scheduledRepository.FindOne(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errcode.ErrScheduleNotExists
+		}
+		return err
+	}
+	if item.UserId != userId {
+		return errcode.ErrScheduleNotExists
+	}
+	return errcode.ErrScheduleNotPending
+}
+
+// runScheduler 只有持有租约的副本才扫描到期消息，停机期间到期的消息在下次扫描时补发
+func (s *Server) runScheduler() {
+	lease := redis.NewLease(s.redis, schedulerLeaseKey, schedulerLeaseTTL)
+	t := time.NewTicker(schedulerInterval)
+	defer t.Stop()
+	leader := false
+	for range t.C {
+		ctx := context.Background()
+		ok, err := lease.Acquire(ctx)
+		if err != nil {
+			log.Errorf("acquire scheduler lease failed, err: %v", err)
+			continue
+		}
+		if !ok {
+			leader = false
+			continue
+		}
+		if !leader {
+			leader = true
+			if err := s.scheduledRepository.ResetStuck(ctx, time.Now().Add(-scheduleStuckTimeout)); err != nil {
+				log.Errorf("err: %v", err)
+			}
+		}
+		s.dispatchDue(ctx)
+	}
+}
+
+func (s *Server) dispatchDue(ctx context.Context) {
+	for {
+		list, err := s.scheduledRepository.ListDue(ctx, time.Now(), schedulerBatch)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		for _, item := range list {
+			s.dispatchScheduled(ctx, item)
+		}
+		if len(list) < schedulerBatch {
+			return
+		}
+	}
+}
+
+func (s *Server) dispatchScheduled(ctx context.Context, item *model.ScheduledMessage) {
+	ok, err := s.scheduledRepository.Claim(ctx, item.ID)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	var mentions []int64
+	if item.Mentions != "" {
+		_ = mjson.Unmarshal([]byte(item.Mentions), &mentions)
+	}
+	// 走正常发送流程，client_msg_id 保证重试不会重复发送
+	resp, err := s.SendMessage(ctx, &message.SendMessageReq{
+		UserId:      item.UserId,
+		ToId:        item.ToId,
+		Kind:        item.Kind,
+		Message:     item.Content,
+		Type:        item.Type,
+		Mentions:    mentions,
+		MentionAll:  item.MentionAll,
+		ClientMsgId: fmt.Sprintf("sched-%d", item.ID),
+	})
+	status, msgId, errMsg := model.ScheduleStatusSent, int64(0), ""
+	if err != nil {
+		log.Errorf("dispatch scheduled message %d failed, err: %v", item.ID, err)
+		status, errMsg = model.ScheduleStatusFailed, err.Error()
+	} else {
+		msgId = resp.Id
+	}
+	if err := s.scheduledRepository.Finish(ctx, item.ID, status, msgId, errMsg); err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
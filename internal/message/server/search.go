@@ -0,0 +1,259 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/message/model"
+	"go-im/internal/message/pkg/search"
+	"go-im/internal/pkg/db"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/utils"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+
+	indexBatchSize = 200
+)
+
+// 内容为服务端生成的 JSON，不参与检索
+var unsearchableTypes = []string{model.MessageTypeSystem, model.MessageTypeMerged, model.MessageTypeCard, model.MessageTypeEncrypted}
+
+func (s *Server) initSearch(cfg search.Config, db *db.DB) {
+	idx, err := search.NewIndex(cfg, db)
+	if err != nil {
+		panic(err)
+	}
+	s.search = idx
+	s.indexCh = make(chan *search.Document, 2000)
+	utils.SafeGo(func() {
+		if cfg.Backend == search.BackendMemory {
+			s.backfillIndex()
+		}
+		s.runIndexer()
+	})
+}
+
+func messageDocument(msg *model.Message) *search.Document {
+	return &search.Document{
+		Id:       msg.ID,
+		Kind:     msg.Kind,
+		FromId:   msg.FromId,
+		ToId:     msg.ToId,
+		Seq:      msg.Seq,
+		Type:     msg.Type,
+		Content:  msg.Content,
+		SendTime: msg.CreatedAt.UnixMilli(),
+	}
+}
+
+// searchable 阅后即焚消息不进索引，过期删除只在一个副本上执行，其他副本的内存索引删不掉
+func searchable(msg *model.Message) bool {
+	return !slices.Contains(unsearchableTypes, msg.Type) && msg.Ttl <= 0 && msg.RevokedAt == nil
+}
+
+// 索引写入不阻塞发消息，队列满时丢弃并记录日志
+func (s *Server) indexMessage(msg *model.Message) {
+	if !searchable(msg) {
+		return
+	}
+	select {
+	case s.indexCh <- messageDocument(msg):
+	default:
+		log.Errorf("search index queue full, drop message %d", msg.ID)
+	}
+}
+
+func (s *Server) runIndexer() {
+	batch := make([]*search.Document, 0, indexBatchSize)
+	t := time.NewTicker(time.Second)
+	defer t.Stop()
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := s.search.Add(context.Background(), batch...); err != nil {
+			log.Errorf("err: %v", err)
+		}
+		batch = make([]*search.Document, 0, indexBatchSize)
+	}
+	for {
+		select {
+		case doc := <-s.indexCh:
+			batch = append(batch, doc)
+			if len(batch) >= indexBatchSize {
+				flush()
+			}
+		case <-t.C:
+			flush()
+		}
+	}
+}
+
+// 内存索引启动时从库里全量灌入
+func (s *Server) backfillIndex() {
+	ctx := context.Background()
+	var lastId int64
+	for {
+		list, err := s.messageRepository.ListAfterId(ctx, lastId, 1000)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		if len(list) == 0 {
+			return
+		}
+		docs := make([]*search.Document, 0, len(list))
+		for _, msg := range list {
+			if searchable(msg) {
+				docs = append(docs, messageDocument(msg))
+			}
+		}
+		_ = s.search.Add(ctx, docs...)
+		lastId = list[len(list)-1].ID
+	}
+}
+
+func (s *Server) SearchMessage(ctx context.Context, in *message.SearchMessageReq) (*message.SearchMessageResp, error) {
+	if len(search.Terms(in.Keyword)) == 0 {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	sessions, err := s.userSessionRepository.ListUserSession(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	groupIds, err := s.groupMemberRepository.ListGroupByUserId(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+
+	// 可见范围：自己的单聊会话加上仍在其中的群
+	sessionMap := make(map[search.Conversation]int64, len(sessions))
+	cleared := make(map[search.Conversation]int64)
+	for _, session := range sessions {
+		c := search.ConversationOf(session.Kind, session.UserId, session.ToId)
+		sessionMap[c] = session.ID
+		if session.ClearedSeq > 0 {
+			cleared[c] = session.ClearedSeq
+		}
+	}
+	scope := make([]search.Conversation, 0, len(sessions)+len(groupIds))
+	for _, session := range sessions {
+		if session.Kind == "single" {
+			scope = append(scope, search.ConversationOf(session.Kind, session.UserId, session.ToId))
+		}
+	}
+	for _, id := range groupIds {
+		scope = append(scope, search.ConversationOf("group", in.UserId, id))
+	}
+	if in.SessionId > 0 {
+		session, err := s.userSessionRepository.FindOne(ctx, in.SessionId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+			}
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if session.UserId != in.UserId {
+			return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+		}
+		c := search.ConversationOf(session.Kind, session.UserId, session.ToId)
+		scope = scope[:0]
+		if session.Kind == "single" || slices.Contains(groupIds, session.ToId) {
+			scope = append(scope, c)
+		}
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	hits, err := s.search.Search(ctx, &search.Query{
+		Keyword:       in.Keyword,
+		Conversations: scope,
+		ClearedSeq:    cleared,
+		FromId:        in.FromId,
+		ExcludeTypes:  unsearchableTypes,
+		StartTime:     in.StartTime,
+		EndTime:       in.EndTime,
+		Offset:        int(in.Offset),
+		Limit:         min(limit, maxSearchLimit),
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+
+	// 过滤掉仅对自己删除的消息，并以库为准过滤已撤回和已过期的消息，
+	// 内存索引可能因为删除只发生在其他副本或与索引写入并发而残留
+	if len(hits) > 0 {
+		ids := make([]int64, 0, len(hits))
+		for _, hit := range hits {
+			ids = append(ids, hit.Doc.Id)
+		}
+		deleted, err := s.deletionRepository.ListDeleted(ctx, in.UserId, ids)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		alive, err := s.messageRepository.ListAliveIds(ctx, ids)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		hits = slices.DeleteFunc(hits, func(hit *search.Hit) bool {
+			_, isDeleted := deleted[hit.Doc.Id]
+			_, isAlive := alive[hit.Doc.Id]
+			return isDeleted || !isAlive
+		})
+	}
+
+	userIds := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		userIds = append(userIds, hit.Doc.FromId)
+	}
+	users, err := s.userInfos(ctx, userIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &message.SearchMessageResp{
+		List: make([]*message.SearchMessageHit, 0, len(hits)),
+	}
+	for _, hit := range hits {
+		doc := hit.Doc
+		info := users[doc.FromId]
+		toId := doc.ToId
+		if doc.Kind == "single" && toId == in.UserId {
+			toId = doc.FromId
+		}
+		resp.List = append(resp.List, &message.SearchMessageHit{
+			SessionId: sessionMap[search.ConversationOf(doc.Kind, doc.FromId, doc.ToId)],
+			Kind:      doc.Kind,
+			ToId:      toId,
+			Message: &message.MessageInfo{
+				Id:         doc.Id,
+				Kind:       doc.Kind,
+				Content:    doc.Content,
+				Seq:        doc.Seq,
+				FromId:     doc.FromId,
+				SendTime:   doc.SendTime,
+				FromName:   info.Username,
+				FromAvatar: info.Avatar,
+				Type:       doc.Type,
+			},
+			Snippet: hit.Snippet,
+		})
+	}
+	return resp, nil
+}
@@ -12,14 +12,22 @@ import (
 	"go-im/internal/common/types"
 	"go-im/internal/message/config"
 	"go-im/internal/message/model"
+	"go-im/internal/message/pkg/search"
 	"go-im/internal/message/repository"
 	"go-im/internal/pkg/db"
 	"go-im/internal/pkg/kafka"
 	"go-im/internal/pkg/log"
 	"go-im/internal/pkg/mjson"
+	"go-im/internal/pkg/moderation"
+	"go-im/internal/pkg/outbox"
+	"go-im/internal/pkg/push"
 	"go-im/internal/pkg/redis"
 	"go-im/internal/pkg/utils"
+	"go-im/internal/pkg/webhook"
+	"math"
+	"slices"
 	"strconv"
+	"time"
 
 	kafkago "github.com/segmentio/kafka-go"
 	"gorm.io/gorm"
@@ -28,48 +36,150 @@ import (
 type Server struct {
 	message.UnimplementedMessageServer
 
-	redis                 *redis.Redis
-	groupRepository       *repository.GroupRepository
-	groupApplyRepository  *repository.GroupApplyRepository
-	groupMemberRepository *repository.GroupMemberRepository
-	messageRepository     *repository.MessageRepository
-	userGroupRepository   *repository.UserGroupRepository
-	userSessionRepository *repository.UserSessionRepository
+	redis                   *redis.Redis
+	groupRepository         *repository.GroupRepository
+	groupApplyRepository    *repository.GroupApplyRepository
+	groupMemberRepository   *repository.GroupMemberRepository
+	messageRepository       *repository.MessageRepository
+	mentionRepository       *repository.MessageMentionRepository
+	conversationRepository  *repository.ConversationRepository
+	deletionRepository      *repository.MessageDeletionRepository
+	scheduledRepository     *repository.ScheduledMessageRepository
+	forwardBundleRepository *repository.ForwardBundleRepository
+	broadcastRepository     *repository.BroadcastRepository
+	exportJobRepository     *repository.ExportJobRepository
+	userGroupRepository     *repository.UserGroupRepository
+	userSessionRepository   *repository.UserSessionRepository
 
 	userRpc      user.UserClient
 	accessClient access.AccessClient
 
 	kafkaWriter *kafka.Writer
 
-	pushCh chan protocol.PushBody
+	db        *db.DB
+	outbox    *outbox.Outbox
+	webhook   *webhook.Webhook
+	moderator *moderation.Moderator
+	receipts  *receiptBatcher
+
+	search  search.Index
+	indexCh chan *search.Document
+
+	revokeWindow time.Duration
+
+	strangerPolicy string
+	strangerLimit  int
+
+	exportRetention time.Duration
+
+	pusher     push.Provider
+	pushCh     chan *pushTask
+	pushWindow time.Duration
+
+	unreadRepairInterval time.Duration
 }
 
 func NewServer(cfg *config.Config, redis *redis.Redis, db *db.DB, kafkaWriter *kafka.Writer, userRpcClient user.UserClient, accessClient access.AccessClient) *Server {
 	s := &Server{
-		redis:                 redis,
-		kafkaWriter:           kafkaWriter,
-		groupRepository:       repository.NewGroupRepository(db),
-		groupApplyRepository:  repository.NewGroupApplyRepository(db),
-		groupMemberRepository: repository.NewGroupMemberRepository(db),
-		messageRepository:     repository.NewMessageRepository(db),
-		userGroupRepository:   repository.NewUserGroupRepository(db),
-		userSessionRepository: repository.NewUserSessionRepository(db),
-		userRpc:               userRpcClient,
-		accessClient:          accessClient,
-		pushCh:                make(chan protocol.PushBody, 2000),
+		redis:                   redis,
+		db:                      db,
+		kafkaWriter:             kafkaWriter,
+		groupRepository:         repository.NewGroupRepository(db),
+		groupApplyRepository:    repository.NewGroupApplyRepository(db),
+		groupMemberRepository:   repository.NewGroupMemberRepository(db),
+		messageRepository:       repository.NewMessageRepository(db),
+		mentionRepository:       repository.NewMessageMentionRepository(db),
+		conversationRepository:  repository.NewConversationRepository(db),
+		deletionRepository:      repository.NewMessageDeletionRepository(db),
+		scheduledRepository:     repository.NewScheduledMessageRepository(db),
+		forwardBundleRepository: repository.NewForwardBundleRepository(db),
+		broadcastRepository:     repository.NewBroadcastRepository(db),
+		exportJobRepository:     repository.NewExportJobRepository(db),
+		userGroupRepository:     repository.NewUserGroupRepository(db),
+		userSessionRepository:   repository.NewUserSessionRepository(db),
+		userRpc:                 userRpcClient,
+		accessClient:            accessClient,
+	}
+	s.outbox = outbox.New(db, redis, "message", s.publish)
+	s.outbox.Run()
+	s.webhook = webhook.New(db, redis, "message")
+	s.webhook.Run()
+	moderator, err := moderation.New(cfg.Moderation, db, "message")
+	if err != nil {
+		panic(err)
+	}
+	s.moderator = moderator
+	s.receipts = newReceiptBatcher(time.Duration(cfg.ReceiptInterval)*time.Millisecond, s.flushReceipt)
+	s.initSearch(cfg.Search, db)
+	s.initPush(cfg.Push)
+	s.revokeWindow = time.Duration(cfg.RevokeWindow) * time.Second
+	if s.revokeWindow <= 0 {
+		s.revokeWindow = defaultRevokeWindow
 	}
+	s.strangerPolicy, s.strangerLimit = cfg.StrangerPolicy, cfg.StrangerLimit
+	if s.strangerPolicy == "" {
+		s.strangerPolicy = types.MessagePolicyOpen
+	}
+	if s.strangerLimit <= 0 {
+		s.strangerLimit = defaultStrangerLimit
+	}
+	s.exportRetention = time.Duration(cfg.Export.Retention) * time.Hour
+	if s.exportRetention <= 0 {
+		s.exportRetention = defaultExportRetention
+	}
+	s.unreadRepairInterval = time.Duration(cfg.UnreadRepairInterval) * time.Minute
+	if s.unreadRepairInterval <= 0 {
+		s.unreadRepairInterval = defaultUnreadRepairInterval
+	}
+	utils.SafeGo(func() {
+		s.runBroadcaster()
+	})
+	utils.SafeGo(func() {
+		s.runScheduler()
+	})
 	utils.SafeGo(func() {
-		s.consume()
+		s.runExpirer()
+	})
+	utils.SafeGo(func() {
+		s.runExporter()
+	})
+	utils.SafeGo(func() {
+		s.runUnreadRepair()
 	})
 	return s
 }
 
 func (s *Server) AckMessage(ctx context.Context, in *message.AckMessageReq) (*message.AckMessageResp, error) {
-	err := s.userSessionRepository.UpdateSessionSeq(ctx, in.SessionId, int64(in.Seq))
+	session, err := s.userSessionRepository.FindOne(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if session.UserId != in.UserId {
+		return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+	}
+	if in.Seq <= session.Seq {
+		return &message.AckMessageResp{}, nil
+	}
+	err = s.userSessionRepository.UpdateSessionSeq(ctx, in.SessionId, int64(in.Seq))
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
+	s.ackUnread(ctx, session, int64(in.Seq))
+	// read 模式的阅后即焚消息从已读开始计时
+	err = s.messageRepository.StartExpire(ctx, session.Kind, session.UserId, session.ToId, session.Seq, in.Seq)
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	if session.Kind == "group" {
+		s.receipts.Add(receiptKey{kind: session.Kind, id: session.ToId}, session.ToId, session.UserId, in.Seq)
+	} else {
+		s.receipts.Add(receiptKey{kind: session.Kind, id: session.ID}, session.ToId, session.UserId, in.Seq)
+	}
 	return &message.AckMessageResp{}, nil
 }
 
@@ -103,26 +213,35 @@ func (s *Server) ApplyInGroup(ctx context.Context, in *message.ApplyInGroupReq)
 		UserId:  in.UserId,
 		Status:  repository.GroupApplyWaitStatus,
 	}
-	_, err = s.groupApplyRepository.Insert(ctx, &apply)
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if _, err := s.groupApplyRepository.Insert(ctx, &apply); err != nil {
+			return err
+		}
+		msg := access.GroupApplyMsg{
+			UserId:  group.OwnerId,
+			GroupId: group.ID,
+		}
+		b, _ := mjson.Marshal(&msg)
+		return s.push(ctx, protocol.PushBody{
+			Type: protocol.GroupEventTopic,
+			Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupApplyMsg),
+			Body: b,
+		})
+	})
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-
-	msg := access.GroupApplyMsg{
-		UserId:  group.OwnerId,
-		GroupId: group.ID,
-	}
-	b, _ := mjson.Marshal(&msg)
-	s.push(protocol.PushBody{
-		Type: protocol.GroupEventTopic,
-		Key:  fmt.Appendf([]byte{}, "%d", group.OwnerId),
-		Body: b,
-	})
 	return &message.ApplyInGroupResp{}, nil
 }
 
 func (s *Server) CreateGroup(ctx context.Context, in *message.CreateGroupReq) (*message.CreateGroupResq, error) {
+	name, err := s.moderator.Check(ctx, moderation.SceneGroupName, in.UserId, in.Name)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	in.Name = name
 	no, err := s.genGroupNo(ctx)
 	if err != nil {
 		log.Errorf("err: %v", err)
@@ -178,12 +297,42 @@ func (s *Server) CreateSession(ctx context.Context, in *message.CreateSessionReq
 }
 
 func (s *Server) DeleteUserSession(ctx context.Context, in *message.DeleteUserSessionReq) (*message.DeleteUserSessionResp, error) {
-	// TODO 标记为删除即可，再次发起聊天时重新打开
-	err := s.userSessionRepository.Delete(ctx, in.SessionId)
+	session, err := s.userSessionRepository.FindOne(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if session.UserId != in.UserId {
+		return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+	}
+	// 只标记隐藏并记下当前序号，再次收发消息时重新打开
+	var clearedSeq int64
+	conv, err := s.conversationRepository.FindByKey(ctx, session.Kind, session.UserId, session.ToId)
+	if err == nil {
+		clearedSeq = conv.Seq
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	err = s.userSessionRepository.Hide(ctx, session.ID, clearedSeq)
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
+	s.ackUnread(ctx, session, clearedSeq)
+	if session.Kind == "single" {
+		key := fmt.Sprintf("session:single:%d-%d", session.UserId, session.ToId)
+		_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+			cmd := s.redis.Del(ctx, key)
+			return cmd.Val(), cmd.String(), cmd.Err()
+		})
+		if err != nil {
+			log.Errorf("err: %v", err)
+		}
+	}
 	return &message.DeleteUserSessionResp{}, nil
 }
 
@@ -196,33 +345,16 @@ func (s *Server) DismissGroup(ctx context.Context, in *message.DismissGroupReq)
 	if group.OwnerId != in.UserId {
 		return nil, errcode.ToRpcError(errcode.ErrGroupOwnerOnly)
 	}
-	err = s.groupRepository.DismissGroup(ctx, in.GroupId)
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if err := s.groupRepository.DismissGroup(ctx, in.GroupId); err != nil {
+			return err
+		}
+		return s.notifyGroupMembers(ctx, &access.GroupUpdatedInfoMsg{GroupId: group.ID}, protocol.GroupDismissMsg)
+	})
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-
-	onlineUser := make([]int64, 0)
-	members, err := s.groupMemberRepository.ListMember(ctx, group.ID)
-	if err == nil {
-		for _, member := range members {
-			if s.isUserOnline(ctx, member.UserId) {
-				onlineUser = append(onlineUser, member.UserId)
-			}
-		}
-		if len(onlineUser) > 0 {
-			msg := access.GroupUpdatedInfoMsg{
-				GroupId: group.ID,
-				ToId:    onlineUser,
-			}
-			b, _ := mjson.Marshal(&msg)
-			s.push(protocol.PushBody{
-				Type: protocol.GroupEventTopic,
-				Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupDismissMsg),
-				Body: b,
-			})
-		}
-	}
 	return &message.DismissGroupResp{}, nil
 }
 
@@ -235,33 +367,19 @@ func (s *Server) ExitGroup(ctx context.Context, in *message.ExitGroupReq) (*mess
 	if !isMember {
 		return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
 	}
-	err = s.groupMemberRepository.RemvoeMember(ctx, in.GroupId, in.UserId)
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if err := s.groupMemberRepository.RemvoeMember(ctx, in.GroupId, in.UserId); err != nil {
+			return err
+		}
+		return s.notifyGroupMembers(ctx, &access.GroupUpdatedInfoMsg{
+			GroupId: in.GroupId,
+			Left:    []int64{in.UserId},
+		}, protocol.GroupMemberChangeMsg)
+	})
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-
-	onlineUser := make([]int64, 0)
-	members, err := s.groupMemberRepository.ListMember(ctx, in.GroupId)
-	if err == nil {
-		for _, member := range members {
-			if s.isUserOnline(ctx, member.UserId) {
-				onlineUser = append(onlineUser, member.UserId)
-			}
-		}
-		if len(onlineUser) > 0 {
-			msg := access.GroupUpdatedInfoMsg{
-				GroupId: in.GroupId,
-				ToId:    onlineUser,
-			}
-			b, _ := mjson.Marshal(&msg)
-			s.push(protocol.PushBody{
-				Type: protocol.GroupEventTopic,
-				Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupMemberChangeMsg),
-				Body: b,
-			})
-		}
-	}
 	return &message.ExitGroupResp{}, nil
 }
 
@@ -274,22 +392,33 @@ func (s *Server) HandleGroupApply(ctx context.Context, in *message.HandleGroupAp
 	if apply.Status != repository.GroupApplyWaitStatus {
 		return nil, errcode.ToRpcError(errcode.ErrApplyHandled)
 	}
-	err = s.groupApplyRepository.HandleApply(ctx, in.ApplyId, in.Status)
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if err := s.groupApplyRepository.HandleApply(ctx, in.ApplyId, in.Status); err != nil {
+			return err
+		}
+		msg := access.GroupApplyResponseMsg{
+			UserId:  apply.UserId,
+			Status:  in.Status,
+			GroupId: apply.GroupId,
+		}
+		b, _ := mjson.Marshal(&msg)
+		err := s.push(ctx, protocol.PushBody{
+			Type: protocol.GroupEventTopic,
+			Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupAppluResultMsg),
+			Body: b,
+		})
+		if err != nil || in.Status != repository.GroupApplyAccpedStatus {
+			return err
+		}
+		return s.notifyGroupMembers(ctx, &access.GroupUpdatedInfoMsg{
+			GroupId: apply.GroupId,
+			Joined:  []int64{apply.UserId},
+		}, protocol.GroupMemberChangeMsg)
+	})
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return &message.HandleGroupApplyResp{}, errcode.ToRpcError(err)
 	}
-
-	msg := access.GroupApplyResponseMsg{
-		UserId: apply.UserId,
-		Status: in.Status,
-	}
-	b, _ := mjson.Marshal(&msg)
-	s.push(protocol.PushBody{
-		Type: protocol.GroupEventTopic,
-		Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupAppluResultMsg),
-		Body: b,
-	})
 	return &message.HandleGroupApplyResp{}, nil
 }
 
@@ -302,34 +431,19 @@ func (s *Server) InviteMember(ctx context.Context, in *message.InviteMemberReq)
 	if isMember {
 		return nil, errcode.ToRpcError(errcode.ErrWasGroupMember)
 	}
-	err = s.groupMemberRepository.InviteMember(ctx, in.GroupId, in.InvitedIds)
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if err := s.groupMemberRepository.InviteMember(ctx, in.GroupId, in.InvitedIds); err != nil {
+			return err
+		}
+		return s.notifyGroupMembers(ctx, &access.GroupUpdatedInfoMsg{
+			GroupId: in.GroupId,
+			Joined:  in.InvitedIds,
+		}, protocol.GroupMemberChangeMsg)
+	})
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-
-	onlineUser := make([]int64, 0)
-	members, err := s.groupMemberRepository.ListMember(ctx, in.GroupId)
-	if err == nil {
-		for _, member := range members {
-			if s.isUserOnline(ctx, member.UserId) {
-				onlineUser = append(onlineUser, member.UserId)
-			}
-		}
-		if len(onlineUser) > 0 {
-			msg := access.GroupUpdatedInfoMsg{
-				GroupId: in.GroupId,
-				ToId:    onlineUser,
-			}
-			b, _ := mjson.Marshal(&msg)
-
-			s.push(protocol.PushBody{
-				Type: protocol.GroupEventTopic,
-				Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupMemberChangeMsg),
-				Body: b,
-			})
-		}
-	}
 	return &message.InviteMemberResp{}, nil
 }
 
@@ -362,6 +476,15 @@ func (s *Server) ListGroupApply(ctx context.Context, in *message.ListGroupApplyR
 		g = append(g, item)
 		applyMap[item.GroupId] = g
 	}
+	userIds := make([]int64, 0, len(apply))
+	for _, item := range apply {
+		userIds = append(userIds, item.UserId)
+	}
+	users, err := s.userInfos(ctx, userIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
 	resp := make([]*message.ApplyGroup, 0, len(group))
 	for gid, item := range applyMap {
 		ag := &message.ApplyGroup{
@@ -369,13 +492,7 @@ func (s *Server) ListGroupApply(ctx context.Context, in *message.ListGroupApplyR
 			Avatar: groupMap[gid].Avatar,
 		}
 		for _, apply := range item {
-			user, err := s.userRpc.UserInfo(ctx, &user.UserInfoReq{
-				UserId: apply.UserId,
-			})
-			if err != nil {
-				log.Errorf("err: %v", err)
-				return nil, errcode.ToRpcError(err)
-			}
+			user := users[apply.UserId]
 			ag.Apply = append(ag.Apply, &message.UserApply{
 				ApplyId: apply.ID,
 				Name:    user.Username,
@@ -401,28 +518,36 @@ func (s *Server) ListGroup(ctx context.Context, in *message.ListGroupReq) (*mess
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-	groupInfo := make([]*message.GroupInfo, 0, len(groups))
+	groupMembers := make(map[int64][]*model.GroupMember, len(groups))
+	userIds := make([]int64, 0)
 	for _, group := range groups {
 		members, err := s.groupMemberRepository.ListMember(ctx, group.ID)
 		if err != nil {
 			log.Errorf("err: %v", err)
 			return nil, errcode.ToRpcError(err)
 		}
-
+		groupMembers[group.ID] = members
+		for _, mem := range members {
+			userIds = append(userIds, mem.UserId)
+		}
+	}
+	users, err := s.userInfos(ctx, userIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	groupInfo := make([]*message.GroupInfo, 0, len(groups))
+	for _, group := range groups {
+		members := groupMembers[group.ID]
 		m := make([]*message.GroupMember, 0, len(members))
 		for _, mem := range members {
-			info, err := s.userRpc.UserInfo(ctx, &user.UserInfoReq{
-				UserId: mem.UserId,
-			})
-			if err != nil {
-				log.Errorf("err: %v", err)
-				return nil, errcode.ToRpcError(err)
-			}
+			info := users[mem.UserId]
 			m = append(m, &message.GroupMember{
 				Id:      mem.UserId,
 				Name:    info.Username,
 				Avatar:  info.Avatar,
 				IsOwner: group.OwnerId == mem.UserId,
+				IsBot:   info.IsBot,
 			})
 		}
 		groupInfo = append(groupInfo, &message.GroupInfo{
@@ -459,21 +584,25 @@ func (s *Server) ListGroupMember(ctx context.Context, in *message.ListGroupMembe
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
+	userIds := make([]int64, 0, len(members))
+	for _, member := range members {
+		userIds = append(userIds, member.UserId)
+	}
+	users, err := s.userInfos(ctx, userIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
 	list := make([]*message.GroupMember, 0, len(members))
 	for _, member := range members {
-		info, err := s.userRpc.UserInfo(ctx, &user.UserInfoReq{
-			UserId: member.UserId,
-		})
-		if err != nil {
-			log.Errorf("err: %v", err)
-			return nil, errcode.ToRpcError(err)
-		}
+		info := users[member.UserId]
 		list = append(list, &message.GroupMember{
 			Id:        int64(info.UserId),
 			Name:      info.Username,
 			Avatar:    info.Avatar,
 			SessionId: member.SessionId,
 			IsOwner:   group.OwnerId == member.UserId,
+			IsBot:     info.IsBot,
 		})
 	}
 	return &message.ListGroupMemberResp{
@@ -487,59 +616,179 @@ func (s *Server) ListSession(ctx context.Context, in *message.ListSessionReq) (*
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-	infos := make([]*message.SessionInfo, 0)
+	convs, err := s.listSessionConversation(ctx, us)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	lastIds := make([]int64, 0, len(convs))
+	for _, conv := range convs {
+		if conv.LastMsgId > 0 {
+			lastIds = append(lastIds, conv.LastMsgId)
+		}
+	}
+	lastMsgs := make(map[int64]*model.Message, len(lastIds))
+	if len(lastIds) > 0 {
+		list, err := s.messageRepository.ListByIds(ctx, lastIds)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		for _, msg := range list {
+			lastMsgs[msg.ID] = msg
+		}
+	}
+	userIds := make([]int64, 0, len(us)+len(lastMsgs))
+	for _, item := range us {
+		if item.Kind == "single" {
+			userIds = append(userIds, item.ToId)
+		}
+	}
+	for _, msg := range lastMsgs {
+		userIds = append(userIds, msg.FromId)
+	}
+	users, err := s.userInfos(ctx, userIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	groups, counts, mentions, err := s.listSessionGroups(ctx, in.UserId, us)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
 
+	infos := make([]*message.SessionInfo, 0)
+	activity := make(map[int64]int64, len(us))
 	for _, item := range us {
+		if item.HiddenAt != nil {
+			continue
+		}
+		var info *message.SessionInfo
 		if item.Kind == "group" {
-			group, err := s.groupRepository.FindOne(ctx, item.ToId)
-			if err != nil {
-				log.Errorf("err: %v", err)
-				return nil, errcode.ToRpcError(err)
+			group, ok := groups[item.ToId]
+			if !ok {
+				// 群已解散
+				continue
 			}
-			count, err := s.groupMemberRepository.MemberCount(ctx, group.ID)
-			if err != nil {
-				log.Errorf("err: %v", err)
-				return nil, errcode.ToRpcError(err)
+			count := counts[group.ID]
+			info = &message.SessionInfo{
+				SessionId:      item.ID,
+				Kind:           item.Kind,
+				GroupId:        &group.ID,
+				GroupName:      &group.Name,
+				GroupAvatar:    &group.Avatar,
+				MemberCount:    &count,
+				Seq:            item.Seq,
+				UnreadMentions: mentions[group.ID],
 			}
-			infos = append(infos, &message.SessionInfo{
-				SessionId:   item.ID,
-				Kind:        item.Kind,
-				GroupId:     &group.ID,
-				GroupName:   &group.Name,
-				GroupAvatar: &group.Avatar,
-				MemberCount: &count,
-				Seq:         item.Seq,
-			})
 		} else if item.Kind == "single" {
-			// TODO 优化批量
-			userinfo, err := s.userRpc.UserInfo(ctx, &user.UserInfoReq{
-				UserId: item.ToId,
-			})
-			if err != nil {
-				log.Errorf("err: %v", err)
-				return nil, errcode.ToRpcError(err)
-			}
+			userinfo := users[item.ToId]
 			uid := int64(userinfo.UserId)
-			infos = append(infos, &message.SessionInfo{
+			info = &message.SessionInfo{
 				SessionId:    item.ID,
 				Kind:         item.Kind,
 				FriendId:     &uid,
 				FriendName:   &userinfo.Username,
 				FriendAvatar: &userinfo.Avatar,
 				Seq:          item.Seq,
-			})
+			}
+		} else {
+			continue
+		}
+		fillSessionFlags(info, item)
+		activity[item.ID] = item.CreatedAt.UnixMilli()
+		a, b := model.ConversationKey(item.Kind, item.UserId, item.ToId)
+		if conv, ok := convs[conversationKey{item.Kind, a, b}]; ok {
+			info.UnreadCount = max(conv.Seq-item.Seq, 0)
+			info.Ttl = conv.Ttl
+			info.TtlMode = conv.TtlMode
+			info.Encrypted = conv.Encrypted
+			if msg, ok := lastMsgs[conv.LastMsgId]; ok {
+				sender := users[msg.FromId]
+				info.LastMessage = &message.LastMessage{
+					Id:       msg.ID,
+					Seq:      msg.Seq,
+					FromId:   msg.FromId,
+					FromName: sender.Username,
+					Type:     msg.Type,
+					Excerpt:  excerpt(msg.Content),
+					SendTime: msg.CreatedAt.UnixMilli(),
+					Revoked:  msg.RevokedAt != nil,
+				}
+				// 密文没有可展示的摘要
+				if msg.Type == model.MessageTypeEncrypted {
+					info.LastMessage.Excerpt = ""
+				}
+				activity[item.ID] = info.LastMessage.SendTime
+			}
 		}
+		infos = append(infos, info)
 	}
+	system, err := s.systemSession(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if system != nil {
+		activity[system.SessionId] = system.LastMessage.SendTime
+		infos = append(infos, system)
+	}
+	sortSession(infos, activity)
 	return &message.ListSessionResp{
 		List: infos,
 	}, nil
 }
 
+// listSessionGroups 批量查询会话列表中群的信息、成员数和未读@数
+func (s *Server) listSessionGroups(ctx context.Context, userId int64, sessions []*model.UserSession) (map[int64]*model.Group, map[int64]int64, map[int64]int64, error) {
+	ids := make([]int64, 0)
+	seqs := make(map[int64]int64)
+	for _, item := range sessions {
+		if item.Kind == "group" && item.HiddenAt == nil {
+			ids = append(ids, item.ToId)
+			seqs[item.ToId] = item.Seq
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil, nil, nil
+	}
+	list, err := s.groupRepository.ListByIds(ctx, ids)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	groups := make(map[int64]*model.Group, len(list))
+	for _, group := range list {
+		groups[group.ID] = group
+	}
+	counts, err := s.groupMemberRepository.MemberCounts(ctx, ids)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	mentions, err := s.mentionRepository.CountUnreadByGroup(ctx, userId, seqs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return groups, counts, mentions, nil
+}
+
 func (s *Server) ListUnReadMessage(ctx context.Context, in *message.ListUnReadMessageReq) (*message.ListUnReadMessageResp, error) {
 	var (
 		result []*model.Message
 		err    error
 	)
+	toId := in.FromId
+	if in.Kind == "group" {
+		toId = in.GroupId
+	}
+	// 不返回用户已清空的消息
+	session, err := s.userSessionRepository.FindByPeer(ctx, in.UserId, toId, in.Kind)
+	if err == nil {
+		in.Seq = max(in.Seq, session.ClearedSeq+1)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
 	if in.Kind == "single" {
 		result, err = s.messageRepository.ListUnRead(ctx, in.UserId, in.FromId, in.Seq)
 		if err != nil {
@@ -547,7 +796,12 @@ func (s *Server) ListUnReadMessage(ctx context.Context, in *message.ListUnReadMe
 			return nil, errcode.ToRpcError(err)
 		}
 	} else if in.Kind == "group" {
-		result, err = s.messageRepository.ListGroupUnRead(ctx, in.GroupId, in.Seq)
+		result, err = s.messageRepository.ListGroupUnRead(ctx, in.UserId, in.GroupId, in.Seq)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		result, err = s.filterHidden(ctx, in.UserId, result)
 		if err != nil {
 			log.Errorf("err: %v", err)
 			return nil, errcode.ToRpcError(err)
@@ -556,60 +810,337 @@ func (s *Server) ListUnReadMessage(ctx context.Context, in *message.ListUnReadMe
 	infos := make([]*message.MessageInfo, 0, len(result))
 	for _, item := range result {
 		infos = append(infos, &message.MessageInfo{
-			Id:      item.ID,
-			Content: item.Content,
-			Seq:     item.Seq,
-			Kind:    item.Kind,
-			FromId:  item.FromId,
+			Id:       item.ID,
+			Content:  item.Content,
+			Seq:      item.Seq,
+			Kind:     item.Kind,
+			FromId:   item.FromId,
+			Type:     item.Type,
+			Revoked:  item.RevokedAt != nil,
+			ExpireAt: expireAt(item),
 		})
 	}
+	if in.Kind == "group" && len(infos) > 0 {
+		err = s.fillMentions(ctx, infos)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		err = s.fillReadCount(ctx, in.UserId, in.Kind, in.GroupId, infos)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+	}
 	return &message.ListUnReadMessageResp{List: infos}, nil
 }
 
-func (s *Server) MoveOutMember(ctx context.Context, in *message.MoveOutMemberReq) (*message.MoveOutMemberResp, error) {
-	group, err := s.groupRepository.FindOne(ctx, in.GroupId)
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+func (s *Server) ListHistory(ctx context.Context, in *message.ListHistoryReq) (*message.ListHistoryResp, error) {
+	session, err := s.userSessionRepository.FindOne(ctx, in.SessionId)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
+		}
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-	if group.OwnerId != in.OpUserId {
-		return nil, errcode.ToRpcError(errcode.ErrGroupOwnerOnly)
+	if session.UserId != in.UserId {
+		return nil, errcode.ToRpcError(errcode.ErrSessionNotExists)
 	}
-	isMember, err := s.groupMemberRepository.IsMember(ctx, in.GroupId, in.UserId)
-	if err != nil {
-		log.Errorf("err: %v", err)
-		return nil, errcode.ToRpcError(err)
+	if session.Kind == "group" {
+		isMember, err := s.groupMemberRepository.IsMember(ctx, session.ToId, in.UserId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if !isMember {
+			return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
+		}
 	}
-	if !isMember {
-		return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultHistoryLimit
 	}
-	err = s.groupMemberRepository.RemvoeMember(ctx, group.ID, in.UserId)
-	if err != nil {
-		log.Errorf("err: %v", err)
-		return nil, errcode.ToRpcError(err)
+	limit = min(limit, maxHistoryLimit)
+	// 没有锚点时 before 从最新消息向前翻，after 从最早的消息向后翻
+	beforeAnchor, afterAnchor := in.Seq, in.Seq
+	if in.Seq <= 0 {
+		beforeAnchor, afterAnchor = math.MaxInt64, 0
 	}
 
-	onlineUser := make([]int64, 0)
-	members, err := s.groupMemberRepository.ListMember(ctx, in.GroupId)
-	if err == nil {
-		for _, member := range members {
-			if s.isUserOnline(ctx, member.UserId) {
-				onlineUser = append(onlineUser, member.UserId)
-			}
+	var beforeLimit, afterLimit int
+	switch in.Direction {
+	case "", "before":
+		beforeLimit = limit
+	case "after":
+		afterLimit = limit
+	case "around":
+		// 锚点消息本身算在 after 里，没有锚点时只取最新的消息
+		beforeLimit = limit / 2
+		afterLimit = limit - beforeLimit
+		if in.Seq > 0 {
+			afterAnchor = in.Seq - 1
+		} else {
+			beforeLimit, afterLimit = limit, 0
+		}
+	default:
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	resp := &message.ListHistoryResp{}
+	list := make([]*model.Message, 0, limit)
+	if beforeLimit > 0 {
+		// 多取一条用来判断是否还有更早的消息
+		before, err := s.messageRepository.ListHistory(ctx, session.Kind, in.UserId, session.ToId, beforeAnchor, session.ClearedSeq, true, beforeLimit+1)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
 		}
-		if len(onlineUser) > 0 {
-			msg := access.GroupUpdatedInfoMsg{
-				GroupId: in.GroupId,
-				ToId:    onlineUser,
+		if len(before) > beforeLimit {
+			resp.HasMoreBefore = true
+			before = before[:beforeLimit]
+		}
+		slices.Reverse(before)
+		list = append(list, before...)
+	}
+	if afterLimit > 0 {
+		after, err := s.messageRepository.ListHistory(ctx, session.Kind, in.UserId, session.ToId, afterAnchor, session.ClearedSeq, false, afterLimit+1)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if len(after) > afterLimit {
+			resp.HasMoreAfter = true
+			after = after[:afterLimit]
+		}
+		list = append(list, after...)
+	}
+	if session.Kind == "group" {
+		list, err = s.filterHidden(ctx, in.UserId, list)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+	}
+
+	userIds := make([]int64, 0, len(list))
+	for _, item := range list {
+		userIds = append(userIds, item.FromId)
+	}
+	users, err := s.userInfos(ctx, userIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp.List = make([]*message.MessageInfo, 0, len(list))
+	for _, item := range list {
+		info := users[item.FromId]
+		resp.List = append(resp.List, &message.MessageInfo{
+			Id:         item.ID,
+			Kind:       item.Kind,
+			Content:    item.Content,
+			Seq:        item.Seq,
+			FromId:     item.FromId,
+			SendTime:   item.CreatedAt.UnixMilli(),
+			FromName:   info.Username,
+			FromAvatar: info.Avatar,
+			Type:       item.Type,
+			Revoked:    item.RevokedAt != nil,
+			ExpireAt:   expireAt(item),
+		})
+	}
+	if len(resp.List) == 0 {
+		return resp, nil
+	}
+	if session.Kind == "group" {
+		err = s.fillMentions(ctx, resp.List)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+	}
+	err = s.fillReadCount(ctx, in.UserId, session.Kind, session.ToId, resp.List)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return resp, nil
+}
+
+func (s *Server) fillMentions(ctx context.Context, infos []*message.MessageInfo) error {
+	ids := make([]int64, 0, len(infos))
+	for _, info := range infos {
+		ids = append(ids, info.Id)
+	}
+	mentions, err := s.mentionRepository.ListByMessageIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+	mentionMap := make(map[int64][]*model.MessageMention, len(mentions))
+	for _, item := range mentions {
+		mentionMap[item.MessageId] = append(mentionMap[item.MessageId], item)
+	}
+	for _, info := range infos {
+		for _, item := range mentionMap[info.Id] {
+			if item.UserId == 0 {
+				info.MentionAll = true
+			} else {
+				info.Mentions = append(info.Mentions, item.UserId)
 			}
-			b, _ := mjson.Marshal(&msg)
-			s.push(protocol.PushBody{
-				Type: protocol.GroupEventTopic,
-				Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupMemberChangeMsg),
-				Body: b,
-			})
 		}
 	}
+	return nil
+}
+
+func (s *Server) ListMessageReader(ctx context.Context, in *message.ListMessageReaderReq) (*message.ListMessageReaderResp, error) {
+	msg, err := s.messageRepository.FindOne(ctx, in.MessageId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrMessageNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if msg.Kind != "group" {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	isMember, err := s.groupMemberRepository.IsMember(ctx, msg.ToId, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if !isMember {
+		return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
+	}
+	group, err := s.groupRepository.FindOne(ctx, msg.ToId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	members, err := s.groupMemberRepository.ListMember(ctx, msg.ToId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	sessions, err := s.userSessionRepository.ListGroupSession(ctx, msg.ToId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	seqMap := make(map[int64]int64, len(sessions))
+	for _, session := range sessions {
+		seqMap[session.UserId] = session.Seq
+	}
+	userIds := make([]int64, 0, len(members))
+	for _, member := range members {
+		userIds = append(userIds, member.UserId)
+	}
+	users, err := s.userInfos(ctx, userIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &message.ListMessageReaderResp{}
+	for _, member := range members {
+		if member.UserId == msg.FromId {
+			continue
+		}
+		info := users[member.UserId]
+		gm := &message.GroupMember{
+			Id:        member.UserId,
+			Name:      info.Username,
+			Avatar:    info.Avatar,
+			SessionId: member.SessionId,
+			IsOwner:   group.OwnerId == member.UserId,
+			IsBot:     info.IsBot,
+		}
+		if seqMap[member.UserId] >= msg.Seq {
+			resp.Read = append(resp.Read, gm)
+		} else {
+			resp.Unread = append(resp.Unread, gm)
+		}
+	}
+	return resp, nil
+}
+
+// 给当前用户自己发送的消息填充已读人数
+func (s *Server) fillReadCount(ctx context.Context, userId int64, kind string, toId int64, infos []*message.MessageInfo) error {
+	own := false
+	for _, info := range infos {
+		if info.FromId == userId {
+			own = true
+			break
+		}
+	}
+	if !own {
+		return nil
+	}
+	seqs := make([]int64, 0)
+	if kind == "group" {
+		sessions, err := s.userSessionRepository.ListGroupSession(ctx, toId)
+		if err != nil {
+			return err
+		}
+		for _, session := range sessions {
+			if session.UserId != userId {
+				seqs = append(seqs, session.Seq)
+			}
+		}
+	} else {
+		session, err := s.userSessionRepository.GetUserSession(ctx, toId, userId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		seqs = append(seqs, session.Seq)
+	}
+	slices.Sort(seqs)
+	for _, info := range infos {
+		if info.FromId != userId {
+			continue
+		}
+		i, _ := slices.BinarySearch(seqs, info.Seq)
+		info.ReadCount = int64(len(seqs) - i)
+	}
+	return nil
+}
+
+func (s *Server) MoveOutMember(ctx context.Context, in *message.MoveOutMemberReq) (*message.MoveOutMemberResp, error) {
+	group, err := s.groupRepository.FindOne(ctx, in.GroupId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if group.OwnerId != in.OpUserId {
+		return nil, errcode.ToRpcError(errcode.ErrGroupOwnerOnly)
+	}
+	isMember, err := s.groupMemberRepository.IsMember(ctx, in.GroupId, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if !isMember {
+		return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
+	}
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if err := s.groupMemberRepository.RemvoeMember(ctx, group.ID, in.UserId); err != nil {
+			return err
+		}
+		return s.notifyGroupMembers(ctx, &access.GroupUpdatedInfoMsg{
+			GroupId: in.GroupId,
+			Left:    []int64{in.UserId},
+		}, protocol.GroupMemberChangeMsg)
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
 	return &message.MoveOutMemberResp{}, nil
 }
 
@@ -640,7 +1171,37 @@ func (s *Server) SearchGroup(ctx context.Context, in *message.SearchGroupReq) (*
 }
 
 func (s *Server) SendMessage(ctx context.Context, in *message.SendMessageReq) (*message.SendMessageResp, error) {
+	// 合并转发卡片引用服务端保存的聊天记录，只能通过 ForwardMessage 生成
+	if in.Type == model.MessageTypeSystem || in.Type == model.MessageTypeMerged {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	// 重发先于各项检查去重，首次发送之后策略变化或计入限额都不影响重发的结果
+	if resp, err := s.findSentMessage(ctx, in); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	} else if resp != nil {
+		// 客户端重发，直接返回首次发送的结果，不再重复推送
+		return resp, nil
+	}
+	if in.Type == model.MessageTypeCard {
+		if err := s.checkCard(ctx, in.UserId, in.Message); err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+	}
+	if in.Type == model.MessageTypeEncrypted && in.Kind != "single" {
+		return nil, errcode.ToRpcError(errcode.ErrEncryptionDenied)
+	}
+	if isTextType(in.Type) {
+		content, err := s.moderator.Check(ctx, moderation.SceneMessage, in.UserId, in.Message)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		in.Message = content
+	}
 	var sessionId int64
+	var stranger, sent bool
 	if in.Kind == "group" {
 		isMember, err := s.groupMemberRepository.IsMember(ctx, in.ToId, in.UserId)
 		if err != nil {
@@ -651,7 +1212,27 @@ func (s *Server) SendMessage(ctx context.Context, in *message.SendMessageReq) (*
 			return nil, errcode.ToRpcError(errcode.ErrNotGroupMember)
 		}
 	} else if in.Kind == "single" {
+		if err := s.checkBlocked(ctx, in.UserId, in.ToId); err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
 		var err error
+		stranger, err = s.checkStranger(ctx, in.UserId, in.ToId, 1)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if stranger {
+			defer func() {
+				if !sent {
+					s.releaseStranger(ctx, in.UserId, in.ToId, 1)
+				}
+			}()
+		}
+		if err := s.checkEncryption(ctx, in); err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
 		sessionId, err = s.createSessionIfNotExists(ctx, in.UserId, in.ToId)
 		if err != nil {
 			log.Errorf("err: %v", err)
@@ -660,48 +1241,294 @@ func (s *Server) SendMessage(ctx context.Context, in *message.SendMessageReq) (*
 	} else {
 		return nil, errors.New("not supported kind")
 	}
+	mentions, err := s.checkMentions(ctx, in)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
 
 	msg := &model.Message{
-		FromId:  in.UserId,
-		ToId:    in.ToId,
-		Kind:    in.Kind,
-		Content: in.Message,
-		Seq:     in.Seq,
+		FromId:    in.UserId,
+		ToId:      in.ToId,
+		Kind:      in.Kind,
+		Content:   in.Message,
+		ClientSeq: in.Seq,
+		Type:      in.Type,
+	}
+	if msg.Type == "" {
+		msg.Type = "text"
 	}
-	msgId, err := s.messageRepository.Insert(ctx, msg)
+	if in.ClientMsgId != "" {
+		msg.ClientMsgId = &in.ClientMsgId
+	}
+	err = s.postMessage(ctx, msg, sessionId, mentions, in.MentionAll)
 	if err != nil {
-		// if model.IsDuplicateSeq(err) {
-		// 	return nil, errcode.ToRpcError(errcode.ErrMessageExists)
-		// }
+		if in.ClientMsgId != "" && model.IsDuplicateKey(err) {
+			// 并发重试时另一个请求已经写入
+			exist, err := s.messageRepository.FindByClientMsgId(ctx, in.UserId, in.ClientMsgId)
+			if err != nil {
+				log.Errorf("err: %v", err)
+				return nil, errcode.ToRpcError(err)
+			}
+			return sentMessageResp(exist), nil
+		}
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-	if msgId == 0 {
-		return nil, errcode.ToRpcError(errcode.ErrCreateMessage)
+	sent = true
+	if in.Kind == "single" {
+		s.recordSingleMessage(ctx, in.UserId, in.ToId)
+	}
+	resp := sentMessageResp(msg)
+	if in.ClientMsgId != "" {
+		s.cacheSentMessage(ctx, in.UserId, in.ClientMsgId, resp)
 	}
+	return resp, nil
+}
 
-	if in.Kind == "single" {
-		if !s.isUserOnline(ctx, in.ToId) {
-			return nil, nil
+// postMessage 落库并推送，调用方负责权限校验，单聊的 sessionId 为接收方的会话
+func (s *Server) postMessage(ctx context.Context, msg *model.Message, sessionId int64, mentions []int64, mentionAll bool) error {
+	// 单聊对方不在线时不推送，上线后拉取未读
+	online := msg.Kind != "single" || s.isUserOnline(ctx, msg.ToId)
+	var hiddenFor []int64
+	if msg.Kind == "group" {
+		hiddenFor = s.listHiders(ctx, msg.FromId)
+	}
+	mentioned := len(mentions) > 0 || mentionAll
+	var mentionOnline []int64
+	if mentioned {
+		var err error
+		mentionOnline, err = s.mentionOnline(ctx, msg, mentions, mentionAll)
+		if err != nil {
+			return err
 		}
 	}
-	msg2 := access.MessageBody{
-		Id:        msgId,
-		SessionId: sessionId,
-		FromId:    msg.FromId,
-		ToId:      msg.ToId,
-		Content:   msg.Content,
-		Seq:       msg.Seq,
-		Kind:      msg.Kind,
+	err := s.db.InTx(ctx, func(ctx context.Context) error {
+		msgId, err := s.messageRepository.Insert(ctx, msg, mentions, mentionAll)
+		if err != nil {
+			return err
+		}
+		if msgId == 0 {
+			return errcode.ErrCreateMessage
+		}
+		msg2 := access.MessageBody{
+			Id:         msgId,
+			SessionId:  sessionId,
+			FromId:     msg.FromId,
+			ToId:       msg.ToId,
+			Content:    msg.Content,
+			Seq:        msg.Seq,
+			Kind:       msg.Kind,
+			Mentions:   mentions,
+			MentionAll: mentionAll,
+			Type:       msg.Type,
+			HiddenFor:  hiddenFor,
+		}
+		if msg.ExpireAt != nil {
+			msg2.ExpireAt = msg.ExpireAt.UnixMilli()
+		}
+		b, _ := mjson.Marshal(&msg2)
+		body := protocol.PushBody{
+			Type: protocol.MessageTopic,
+			Key:  fmt.Appendf([]byte{}, "%s-%d", msg.Kind, msg.ToId),
+			Body: b,
+		}
+		if online {
+			err = s.push(ctx, body)
+		} else {
+			err = s.emit(ctx, body)
+		}
+		if err != nil {
+			return err
+		}
+		if mentioned {
+			return s.notifyMention(ctx, msg, mentionOnline)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	b, _ := mjson.Marshal(&msg2)
+	s.incrUnread(ctx, msg, mentions, mentionAll)
+	s.indexMessage(msg)
+	if !online || msg.Kind == "group" {
+		s.pushOffline(msg, mentions, mentionAll, hiddenFor)
+	}
+	return nil
+}
 
-	s.push(protocol.PushBody{
-		Type: protocol.MessageTopic,
-		Key:  fmt.Appendf([]byte{}, "%s-%d", msg.Kind, msg.ToId),
-		Body: b,
+const sentMessageTTL = 10 * time.Minute
+
+func sentMessageResp(msg *model.Message) *message.SendMessageResp {
+	return &message.SendMessageResp{
+		Id:       msg.ID,
+		Seq:      msg.Seq,
+		SendTime: msg.CreatedAt.UnixMilli(),
+	}
+}
+
+// 按 client_msg_id 或客户端序号查找已发送的消息，未发送过返回 nil
+func (s *Server) findSentMessage(ctx context.Context, in *message.SendMessageReq) (*message.SendMessageResp, error) {
+	if in.ClientMsgId != "" {
+		key := fmt.Sprintf("msg:client:%d:%s", in.UserId, in.ClientMsgId)
+		ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+			cmd := s.redis.Get(ctx, key)
+			return cmd.Val(), cmd.String(), cmd.Err()
+		})
+		if err == nil {
+			var resp message.SendMessageResp
+			if err := mjson.Unmarshal([]byte(ret.(string)), &resp); err == nil {
+				return &resp, nil
+			}
+		} else if err.Error() != "redis: nil" {
+			log.Errorf("err: %v", err)
+		}
+		exist, err := s.messageRepository.FindByClientMsgId(ctx, in.UserId, in.ClientMsgId)
+		if err == nil {
+			resp := sentMessageResp(exist)
+			s.cacheSentMessage(ctx, in.UserId, in.ClientMsgId, resp)
+			return resp, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return nil, nil
+	}
+	if in.Seq > 0 {
+		exist, err := s.messageRepository.FindByClientSeq(ctx, in.UserId, in.ToId, in.Kind, in.Seq)
+		if err == nil {
+			return sentMessageResp(exist), nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) cacheSentMessage(ctx context.Context, userId int64, clientMsgId string, resp *message.SendMessageResp) {
+	key := fmt.Sprintf("msg:client:%d:%s", userId, clientMsgId)
+	b, _ := mjson.Marshal(resp)
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Set(ctx, key, string(b), sentMessageTTL)
+		return cmd.Val(), cmd.String(), cmd.Err()
 	})
-	return &message.SendMessageResp{}, nil
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+func (s *Server) checkMentions(ctx context.Context, in *message.SendMessageReq) ([]int64, error) {
+	if len(in.Mentions) == 0 && !in.MentionAll {
+		return nil, nil
+	}
+	if in.Kind != "group" {
+		return nil, errcode.ErrInvalidParam
+	}
+	if in.MentionAll {
+		group, err := s.groupRepository.FindOne(ctx, in.ToId)
+		if err != nil {
+			return nil, err
+		}
+		if group.OwnerId != in.UserId {
+			member, err := s.groupMemberRepository.GetMember(ctx, in.ToId, in.UserId)
+			if err != nil {
+				return nil, err
+			}
+			if member.Role != repository.GroupMemberRoleAdmin {
+				return nil, errcode.ErrMentionAllDenied
+			}
+		}
+	}
+	seen := make(map[int64]struct{}, len(in.Mentions))
+	mentions := make([]int64, 0, len(in.Mentions))
+	for _, id := range in.Mentions {
+		if id == in.UserId {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		isMember, err := s.groupMemberRepository.IsMember(ctx, in.ToId, id)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, errcode.ErrMentionNotMember
+		}
+		mentions = append(mentions, id)
+	}
+	return mentions, nil
+}
+
+// 被@的用户即使设置了免打扰也需要收到通知
+// mentionOnline 在线的被@成员，在事务外查询，避免持有会话序号行锁时访问 redis
+func (s *Server) mentionOnline(ctx context.Context, msg *model.Message, mentions []int64, mentionAll bool) ([]int64, error) {
+	to := mentions
+	if mentionAll {
+		members, err := s.groupMemberRepository.ListMember(ctx, msg.ToId)
+		if err != nil {
+			return nil, err
+		}
+		to = make([]int64, 0, len(members))
+		for _, member := range members {
+			if member.UserId != msg.FromId {
+				to = append(to, member.UserId)
+			}
+		}
+	}
+	return s.onlineUsers(ctx, to), nil
+}
+
+func (s *Server) notifyMention(ctx context.Context, msg *model.Message, onlineUser []int64) error {
+	notify := access.MentionNotifyMsg{
+		GroupId:   msg.ToId,
+		MessageId: msg.ID,
+		Seq:       msg.Seq,
+		FromId:    msg.FromId,
+		ToId:      onlineUser,
+	}
+	b, _ := mjson.Marshal(&notify)
+	body := protocol.PushBody{
+		Type: protocol.MessageEventTopic,
+		Key:  fmt.Appendf([]byte{}, "%d", protocol.MentionMsg),
+		Body: b,
+	}
+	if len(onlineUser) == 0 {
+		return s.emit(ctx, body)
+	}
+	return s.push(ctx, body)
+}
+
+const (
+	userInfoBatch  = 500
+	userRpcTimeout = 3 * time.Second
+)
+
+// userInfos 批量获取用户资料，已注销的用户返回只有ID的空资料
+func (s *Server) userInfos(ctx context.Context, ids []int64) (map[int64]*user.UserInfoResp, error) {
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+	users := make(map[int64]*user.UserInfoResp, len(ids))
+	for chunk := range slices.Chunk(ids, userInfoBatch) {
+		ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+		resp, err := s.userRpc.BatchUserInfo(ctx, &user.BatchUserInfoReq{
+			UserIds: chunk,
+		})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range resp.List {
+			users[info.UserId] = info
+		}
+	}
+	for _, id := range ids {
+		if _, ok := users[id]; !ok {
+			users[id] = &user.UserInfoResp{UserId: id}
+		}
+	}
+	return users, nil
 }
 
 func (s *Server) isUserOnline(ctx context.Context, userId int64) bool {
@@ -730,14 +1557,13 @@ func (s *Server) createSessionIfNotExists(ctx context.Context, userId, friendId
 	}
 	ex1 := ret.(string)
 	if ex1 == "" {
-		// TODO 调整为重新打开或者创建
 		sessionId, err := s.userSessionRepository.Create(ctx, &model.UserSession{
 			UserId: userId,
 			ToId:   friendId,
 			Kind:   "single",
 		})
 		if err != nil {
-			return 0, nil
+			return 0, err
 		}
 		if sessionId == 0 {
 			session, err := s.userSessionRepository.GetUserSession(ctx, userId, friendId)
@@ -769,7 +1595,7 @@ func (s *Server) createSessionIfNotExists(ctx context.Context, userId, friendId
 			Kind:   "single",
 		})
 		if err != nil {
-			return 0, nil
+			return 0, err
 		}
 		if sessionId == 0 {
 			session, err := s.userSessionRepository.GetUserSession(ctx, friendId, userId)
@@ -798,62 +1624,89 @@ func (s *Server) UpdateGroupInfo(ctx context.Context, in *message.UpdateGroupInf
 		return nil, errcode.ToRpcError(errcode.ErrGroupOwnerOnly)
 	}
 	if in.Name != "" {
-		group.Name = in.Name
+		group.Name, err = s.moderator.Check(ctx, moderation.SceneGroupName, in.UserId, in.Name)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
 	}
 	if in.Avatar != "" {
 		group.Avatar = in.Avatar
 	}
-	err = s.groupRepository.Update(ctx, group)
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if err := s.groupRepository.Update(ctx, group); err != nil {
+			return err
+		}
+		return s.notifyGroupMembers(ctx, &access.GroupUpdatedInfoMsg{GroupId: group.ID}, protocol.GroupInfoUpdatedMsg)
+	})
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-
-	onlineUser := make([]int64, 0)
-	members, err := s.groupMemberRepository.ListMember(ctx, group.ID)
-	if err == nil {
-		for _, member := range members {
-			if s.isUserOnline(ctx, member.UserId) {
-				onlineUser = append(onlineUser, member.UserId)
-			}
-		}
-		if len(onlineUser) > 0 {
-			msg := access.GroupUpdatedInfoMsg{
-				GroupId: group.ID,
-				ToId:    onlineUser,
-			}
-			b, _ := mjson.Marshal(&msg)
-			s.push(protocol.PushBody{
-				Type: protocol.GroupEventTopic,
-				Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupInfoUpdatedMsg),
-				Body: b,
-			})
-		}
-	}
 	return &message.UpdateGroupInfoResp{}, nil
 }
 
-func (s *Server) push(body protocol.PushBody) {
-	s.pushCh <- body
+// push 写入 outbox，ctx 中有事务时随业务数据一起提交
+func (s *Server) push(ctx context.Context, body protocol.PushBody) error {
+	if err := s.emit(ctx, body); err != nil {
+		return err
+	}
+	return s.outbox.Add(ctx, &outbox.Event{
+		Topic: body.Type,
+		Key:   body.Key,
+		Body:  body.Body,
+	})
 }
 
-func (s *Server) consume() {
-	for body := range s.pushCh {
-		if s.accessClient != nil {
-			_, err := s.accessClient.PushMessage(context.TODO(), &access.PushMessageReq{
-				Type: body.Type,
-				Key:  body.Key,
-				Body: body.Body,
-			})
-			if err != nil {
-				log.Errorf("push rpc message failed, err: %v", err)
-			}
-		} else {
-			s.kafkaWriter.Send(kafkago.Message{
-				Topic: body.Type,
-				Key:   body.Key,
-				Value: body.Body,
-			})
-		}
+// emit 只投递给 webhook 订阅方，用于没有在线用户不需要推送的事件
+func (s *Server) emit(ctx context.Context, body protocol.PushBody) error {
+	return s.webhook.Enqueue(ctx, webhook.FromPush(body))
+}
+
+// 群成员变化或群信息更新后通知在线成员，msg 中填写群ID和变化的成员
+func (s *Server) notifyGroupMembers(ctx context.Context, msg *access.GroupUpdatedInfoMsg, contentType int) error {
+	members, err := s.groupMemberRepository.ListMember(ctx, msg.GroupId)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.UserId)
+	}
+	onlineUser := s.onlineUsers(ctx, ids)
+	msg.ToId = onlineUser
+	b, _ := mjson.Marshal(msg)
+	body := protocol.PushBody{
+		Type: protocol.GroupEventTopic,
+		Key:  fmt.Appendf([]byte{}, "%d", contentType),
+		Body: b,
+	}
+	if len(onlineUser) == 0 {
+		return s.emit(ctx, body)
 	}
+	return s.push(ctx, body)
+}
+
+// publish 投递 outbox 事件，消费端按事件ID去重
+func (s *Server) publish(ctx context.Context, e *outbox.Event) error {
+	if s.accessClient != nil {
+		_, err := s.accessClient.PushMessage(ctx, &access.PushMessageReq{
+			Type: e.Topic,
+			Key:  e.Key,
+			Body: e.Body,
+			Id:   e.EventId(),
+		})
+		return err
+	}
+	return s.kafkaWriter.WriteMessages(ctx, kafkago.Message{
+		Topic:   e.Topic,
+		Key:     e.Key,
+		Value:   e.Body,
+		Headers: []kafkago.Header{{Key: protocol.EventIdHeader, Value: []byte(e.EventId())}},
+	})
+}
+
+// isTextType 客户端发送的纯文本消息，未指定类型时默认为文本
+func isTextType(t string) bool {
+	return t == "" || t == "text"
 }
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const excerptLength = 50
+
+type conversationKey struct {
+	kind string
+	a    int64
+	b    int64
+}
+
+func (s *Server) listSessionConversation(ctx context.Context, sessions []*model.UserSession) (map[conversationKey]*model.Conversation, error) {
+	groupIds := make([]int64, 0)
+	pairs := make([][]int64, 0)
+	for _, item := range sessions {
+		a, b := model.ConversationKey(item.Kind, item.UserId, item.ToId)
+		if item.Kind == "group" {
+			groupIds = append(groupIds, b)
+		} else {
+			pairs = append(pairs, []int64{a, b})
+		}
+	}
+	list, err := s.conversationRepository.ListByKeys(ctx, groupIds, pairs)
+	if err != nil {
+		return nil, err
+	}
+	resp := make(map[conversationKey]*model.Conversation, len(list))
+	for _, conv := range list {
+		resp[conversationKey{conv.Kind, conv.FromId, conv.ToId}] = conv
+	}
+	return resp, nil
+}
+
+func excerpt(content string) string {
+	runes := []rune(content)
+	if len(runes) <= excerptLength {
+		return content
+	}
+	return string(runes[:excerptLength]) + "..."
+}
+
+func fillSessionFlags(info *message.SessionInfo, item *model.UserSession) {
+	if item.PinnedAt != nil {
+		info.Pinned = true
+		info.PinnedAt = item.PinnedAt.UnixMilli()
+	}
+	if item.MutedAt != nil {
+		info.Muted = true
+		info.MutedAt = item.MutedAt.UnixMilli()
+	}
+	if item.ArchivedAt != nil {
+		info.Archived = true
+		info.ArchivedAt = item.ArchivedAt.UnixMilli()
+	}
+}
+
+// 置顶的排在前面，置顶之间按置顶时间倒序，其余按最后活跃时间倒序
+func sortSession(infos []*message.SessionInfo, activity map[int64]int64) {
+	slices.SortStableFunc(infos, func(a, b *message.SessionInfo) int {
+		if a.Pinned != b.Pinned {
+			if a.Pinned {
+				return -1
+			}
+			return 1
+		}
+		if a.Pinned {
+			if c := cmp.Compare(b.PinnedAt, a.PinnedAt); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(activity[b.SessionId], activity[a.SessionId])
+	})
+}
+
+func (s *Server) updateSessionFlag(ctx context.Context, userId int64, sessionId int64, column string, on bool) error {
+	session, err := s.userSessionRepository.FindOne(ctx, sessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errcode.ErrSessionNotExists
+		}
+		return err
+	}
+	if session.UserId != userId {
+		return errcode.ErrSessionNotExists
+	}
+	var at *time.Time
+	if on {
+		now := time.Now()
+		at = &now
+	}
+	return s.userSessionRepository.UpdateFlag(ctx, sessionId, column, at)
+}
+
+func (s *Server) PinSession(ctx context.Context, in *message.PinSessionReq) (*message.PinSessionResp, error) {
+	err := s.updateSessionFlag(ctx, in.UserId, in.SessionId, "pinned_at", in.Pinned)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.PinSessionResp{}, nil
+}
+
+func (s *Server) MuteSession(ctx context.Context, in *message.MuteSessionReq) (*message.MuteSessionResp, error) {
+	err := s.updateSessionFlag(ctx, in.UserId, in.SessionId, "muted_at", in.Muted)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.MuteSessionResp{}, nil
+}
+
+func (s *Server) ArchiveSession(ctx context.Context, in *message.ArchiveSessionReq) (*message.ArchiveSessionResp, error) {
+	err := s.updateSessionFlag(ctx, in.UserId, in.SessionId, "archived_at", in.Archived)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.ArchiveSessionResp{}, nil
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/types"
+	"go-im/internal/pkg/log"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultStrangerLimit = 3
+	// 陌生人计数和回复标记的保留时间
+	strangerStateTTL = 30 * 24 * time.Hour
+)
+
+// 对方回复过时返回 0，超出限额返回 -1，否则预占并返回 1
+var reserveStrangerScript = goredis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+local n = tonumber(ARGV[1])
+if tonumber(redis.call("GET", KEYS[2]) or "0") + n > tonumber(ARGV[2]) then
+	return -1
+end
+redis.call("INCRBY", KEYS[2], n)
+redis.call("PEXPIRE", KEYS[2], ARGV[3])
+return 1`)
+
+// checkStranger 按接收方的策略检查即将发送的 n 条单聊消息，需要计入陌生人限额时原子地预占 n 条，
+// 返回是否预占，发送失败时调用方用 releaseStranger 归还
+func (s *Server) checkStranger(ctx context.Context, fromId, toId int64, n int) (bool, error) {
+	if fromId == toId {
+		return false, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+	defer cancel()
+	infos, err := s.userRpc.BatchUserInfo(ctx, &user.BatchUserInfoReq{UserIds: []int64{toId}})
+	if err != nil {
+		return false, errcode.FromRpcError(err)
+	}
+	if len(infos.List) == 0 {
+		return false, errcode.ErrUserNotExists
+	}
+	policy := infos.List[0].MessagePolicy
+	if policy == "" {
+		policy = s.strangerPolicy
+	}
+	if policy == types.MessagePolicyOpen {
+		return false, nil
+	}
+	// 以接收方的好友列表为准
+	resp, err := s.userRpc.IsFriend(ctx, &user.IsFriendReq{UserId: toId, FriendId: fromId})
+	if err != nil {
+		return false, errcode.FromRpcError(err)
+	}
+	if resp.IsFriend {
+		return false, nil
+	}
+	if policy == types.MessagePolicyFriends {
+		return false, errcode.ErrNotFriend
+	}
+	ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := reserveStrangerScript.Run(ctx, s.redis,
+			[]string{fmt.Sprintf(types.CacheStrangerRepliedKey, fromId, toId), fmt.Sprintf(types.CacheStrangerCountKey, fromId, toId)},
+			n, s.strangerLimit, strangerStateTTL.Milliseconds())
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		// 计数依赖 redis，不可用时放行
+		log.Errorf("err: %v", err)
+		return false, nil
+	}
+	switch n, _ := ret.(int64); n {
+	case -1:
+		return false, errcode.ErrStrangerLimit
+	case 0:
+		return false, nil
+	}
+	return true, nil
+}
+
+// releaseStranger 发送失败时归还 checkStranger 预占的 n 条限额
+func (s *Server) releaseStranger(ctx context.Context, fromId, toId int64, n int) {
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.DecrBy(ctx, fmt.Sprintf(types.CacheStrangerCountKey, fromId, toId), int64(n))
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// recordSingleMessage 单聊消息发送成功后调用，记录发送方已回复过对方，对方的陌生人计数随之清零
+func (s *Server) recordSingleMessage(ctx context.Context, fromId, toId int64) {
+	pipe := s.redis.Pipeline()
+	pipe.Set(ctx, fmt.Sprintf(types.CacheStrangerRepliedKey, toId, fromId), 1, strangerStateTTL)
+	pipe.Del(ctx, fmt.Sprintf(types.CacheStrangerCountKey, toId, fromId))
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline record single message", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
@@ -0,0 +1,347 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"go-im/api/message"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/types"
+	"go-im/internal/message/model"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/redis"
+	"slices"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	unreadCacheTTL = 7 * 24 * time.Hour
+	// 存在该字段表示计数已从库中完整加载，增量写入可能只建出部分字段
+	unreadLoadedField = "loaded"
+
+	unreadRepairLeaseKey        = "message:unread:lease"
+	unreadRepairBatch           = 200
+	defaultUnreadRepairInterval = time.Hour
+)
+
+var decrUnreadScript = goredis.NewScript(`
+local n = tonumber(redis.call("HGET", KEYS[1], ARGV[1]) or "0")
+if n > 0 then
+	return redis.call("HINCRBY", KEYS[1], ARGV[1], -1)
+end
+return 0
+`)
+
+func unreadField(kind string, toId int64) string {
+	return fmt.Sprintf("%s:%d", kind, toId)
+}
+
+func mentionField(groupId int64) string {
+	return fmt.Sprintf("mention:%d", groupId)
+}
+
+// GetUnreadSummary 读取缓存的计数，缓存未加载时从库中重建
+func (s *Server) GetUnreadSummary(ctx context.Context, in *message.GetUnreadSummaryReq) (*message.GetUnreadSummaryResp, error) {
+	sessions, err := s.userSessionRepository.ListUserSession(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	counts, err := s.loadUnread(ctx, in.UserId, sessions)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &message.GetUnreadSummaryResp{
+		List: make([]*message.SessionUnread, 0),
+	}
+	for _, item := range sessions {
+		if item.HiddenAt != nil {
+			continue
+		}
+		unread := counts[unreadField(item.Kind, item.ToId)]
+		var mentions int64
+		if item.Kind == "group" {
+			mentions = counts[mentionField(item.ToId)]
+		}
+		if unread <= 0 && mentions <= 0 {
+			continue
+		}
+		muted := item.MutedAt != nil
+		resp.List = append(resp.List, &message.SessionUnread{
+			SessionId: item.ID,
+			Kind:      item.Kind,
+			ToId:      item.ToId,
+			Unread:    unread,
+			Mentions:  mentions,
+			Muted:     muted,
+		})
+		if !muted {
+			resp.Total += unread
+		}
+		resp.Mentions += mentions
+	}
+	system, err := s.broadcastRepository.CountUnread(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if system > 0 {
+		resp.List = append(resp.List, &message.SessionUnread{
+			Kind:   "system",
+			Unread: system,
+		})
+		resp.Total += system
+	}
+	return resp, nil
+}
+
+// incrUnread 消息落库后给其他参与者的计数加一，发送者的 user_session.seq 已随消息写入推进，不计未读
+func (s *Server) incrUnread(ctx context.Context, msg *model.Message, mentions []int64, mentionAll bool) {
+	var userIds []int64
+	if msg.Kind == "group" {
+		members, err := s.groupMemberRepository.ListMember(ctx, msg.ToId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		userIds = make([]int64, 0, len(members))
+		for _, member := range members {
+			userIds = append(userIds, member.UserId)
+		}
+	} else {
+		userIds = []int64{msg.ToId}
+	}
+	incrs := unreadIncrs(msg, userIds, mentions, mentionAll)
+	if len(incrs) == 0 {
+		return
+	}
+	pipe := s.redis.Pipeline()
+	for id, fields := range incrs {
+		key := fmt.Sprintf(types.CacheUnreadKey, id)
+		for _, field := range fields {
+			pipe.HIncrBy(ctx, key, field, 1)
+		}
+		pipe.Expire(ctx, key, unreadCacheTTL)
+	}
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline incr unread", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// unreadIncrs 每个接收者需要加一的计数字段，跳过发送者
+func unreadIncrs(msg *model.Message, userIds []int64, mentions []int64, mentionAll bool) map[int64][]string {
+	incrs := make(map[int64][]string, len(userIds))
+	for _, id := range userIds {
+		if id == msg.FromId {
+			continue
+		}
+		if msg.Kind == "single" {
+			incrs[id] = []string{unreadField(msg.Kind, msg.FromId)}
+			continue
+		}
+		fields := []string{unreadField(msg.Kind, msg.ToId)}
+		if mentionAll || slices.Contains(mentions, id) {
+			fields = append(fields, mentionField(msg.ToId))
+		}
+		incrs[id] = fields
+	}
+	return incrs
+}
+
+// ackUnread 按已读序号重新统计并直接覆盖计数，并发确认时不会扣成负数，@计数同样从库中重新统计
+func (s *Server) ackUnread(ctx context.Context, session *model.UserSession, seq int64) {
+	if seq <= session.Seq {
+		return
+	}
+	unread, err := s.messageRepository.CountUnread(ctx, session.Kind, session.UserId, session.ToId, seq)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	key := fmt.Sprintf(types.CacheUnreadKey, session.UserId)
+	pipe := s.redis.Pipeline()
+	pipe.HSet(ctx, key, unreadField(session.Kind, session.ToId), unread)
+	if session.Kind == "group" {
+		mentions, err := s.mentionRepository.CountUnread(ctx, session.ToId, session.UserId, seq)
+		if err != nil {
+			log.Errorf("err: %v", err)
+		} else {
+			pipe.HSet(ctx, key, mentionField(session.ToId), mentions)
+		}
+	}
+	pipe.Expire(ctx, key, unreadCacheTTL)
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline ack unread", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// decrUnread 消息撤回或过期后给还没读到它的接收者减一，只改已缓存的计数，不会减成负数
+func (s *Server) decrUnread(ctx context.Context, msg *model.Message) {
+	userIds, err := s.userSessionRepository.ListUnreadUserIds(ctx, msg)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	if len(userIds) == 0 {
+		return
+	}
+	field := unreadField(msg.Kind, msg.ToId)
+	if msg.Kind == "single" {
+		field = unreadField(msg.Kind, msg.FromId)
+	}
+	pipe := s.redis.Pipeline()
+	for _, id := range userIds {
+		decrUnreadScript.Eval(ctx, pipe, []string{fmt.Sprintf(types.CacheUnreadKey, id)}, field)
+	}
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline decr unread", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+func (s *Server) loadUnread(ctx context.Context, userId int64, sessions []*model.UserSession) (map[string]int64, error) {
+	ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.HGetAll(ctx, fmt.Sprintf(types.CacheUnreadKey, userId))
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	} else if fields := ret.(map[string]string); fields[unreadLoadedField] != "" {
+		counts := make(map[string]int64, len(fields))
+		for k, v := range fields {
+			counts[k], _ = strconv.ParseInt(v, 10, 64)
+		}
+		return counts, nil
+	}
+	return s.rebuildUnread(ctx, userId, sessions)
+}
+
+// rebuildUnread 统计 user_session.seq 之后未撤回、未过期的消息数并覆盖缓存，
+// 计算期间到达的消息可能漏计，由下一轮校正修复
+func (s *Server) rebuildUnread(ctx context.Context, userId int64, sessions []*model.UserSession) (map[string]int64, error) {
+	convs, err := s.listSessionConversation(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64)
+	for _, item := range sessions {
+		a, b := model.ConversationKey(item.Kind, item.UserId, item.ToId)
+		conv, ok := convs[conversationKey{item.Kind, a, b}]
+		if !ok || conv.Seq <= item.Seq {
+			continue
+		}
+		unread, err := s.messageRepository.CountUnread(ctx, item.Kind, userId, item.ToId, item.Seq)
+		if err != nil {
+			return nil, err
+		}
+		if unread > 0 {
+			counts[unreadField(item.Kind, item.ToId)] = unread
+		}
+		if item.Kind == "group" {
+			mentions, err := s.mentionRepository.CountUnread(ctx, item.ToId, userId, item.Seq)
+			if err != nil {
+				return nil, err
+			}
+			if mentions > 0 {
+				counts[mentionField(item.ToId)] = mentions
+			}
+		}
+	}
+	values := make([]any, 0, 2*len(counts)+2)
+	values = append(values, unreadLoadedField, 1)
+	for k, v := range counts {
+		values = append(values, k, v)
+	}
+	key := fmt.Sprintf(types.CacheUnreadKey, userId)
+	pipe := s.redis.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, values...)
+	pipe.Expire(ctx, key, unreadCacheTTL)
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline set unread", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	return counts, nil
+}
+
+// runUnreadRepair 只有持有租约的副本定期校正已缓存的计数，未缓存的用户在读取时重建，
+// 租约时长等于校正间隔，多个副本每个间隔只校正一次
+func (s *Server) runUnreadRepair() {
+	lease := redis.NewLease(s.redis, unreadRepairLeaseKey, s.unreadRepairInterval)
+	t := time.NewTicker(s.unreadRepairInterval)
+	defer t.Stop()
+	for range t.C {
+		ctx := context.Background()
+		ok, err := lease.Acquire(ctx)
+		if err != nil {
+			log.Errorf("acquire unread repair lease failed, err: %v", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		s.repairUnread(ctx, lease)
+	}
+}
+
+func (s *Server) repairUnread(ctx context.Context, lease *redis.Lease) {
+	var afterId int64
+	for {
+		ids, err := s.userSessionRepository.ListUserIds(ctx, afterId, unreadRepairBatch)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		if len(ids) == 0 {
+			return
+		}
+		afterId = ids[len(ids)-1]
+		pipe := s.redis.Pipeline()
+		cmds := make([]*goredis.IntCmd, 0, len(ids))
+		for _, id := range ids {
+			cmds = append(cmds, pipe.Exists(ctx, fmt.Sprintf(types.CacheUnreadKey, id)))
+		}
+		_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+			ret, err := pipe.Exec(ctx)
+			return ret, "pipeline exists unread", err
+		})
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return
+		}
+		for i, cmd := range cmds {
+			if cmd.Val() == 0 {
+				continue
+			}
+			sessions, err := s.userSessionRepository.ListUserSession(ctx, ids[i])
+			if err != nil {
+				log.Errorf("err: %v", err)
+				continue
+			}
+			if _, err := s.rebuildUnread(ctx, ids[i], sessions); err != nil {
+				log.Errorf("repair unread of %d failed, err: %v", ids[i], err)
+			}
+		}
+		// 每批续期，租约丢失时交给新的持有者
+		if ok, err := lease.Acquire(ctx); err != nil || !ok {
+			return
+		}
+	}
+}
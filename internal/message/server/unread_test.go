@@ -0,0 +1,36 @@
+package server
+
+import (
+	"go-im/internal/message/model"
+	"slices"
+	"testing"
+)
+
+func TestUnreadIncrsSkipSender(t *testing.T) {
+	msg := &model.Message{Kind: "single", FromId: 1, ToId: 2}
+	incrs := unreadIncrs(msg, []int64{2}, nil, false)
+	if _, ok := incrs[1]; ok {
+		t.Fatalf("sender should not get unread, got %v", incrs[1])
+	}
+	if !slices.Equal(incrs[2], []string{"single:1"}) {
+		t.Fatalf("unexpected fields of receiver: %v", incrs[2])
+	}
+
+	// 给自己发消息时不计未读
+	msg = &model.Message{Kind: "single", FromId: 1, ToId: 1}
+	if incrs := unreadIncrs(msg, []int64{1}, nil, false); len(incrs) != 0 {
+		t.Fatalf("expect no unread for self message, got %v", incrs)
+	}
+
+	msg = &model.Message{Kind: "group", FromId: 1, ToId: 10}
+	incrs = unreadIncrs(msg, []int64{1, 2, 3}, []int64{1, 3}, false)
+	if _, ok := incrs[1]; ok {
+		t.Fatalf("sender should not get unread, got %v", incrs[1])
+	}
+	if !slices.Equal(incrs[2], []string{"group:10"}) {
+		t.Fatalf("unexpected fields of member 2: %v", incrs[2])
+	}
+	if !slices.Equal(incrs[3], []string{"group:10", "mention:10"}) {
+		t.Fatalf("unexpected fields of member 3: %v", incrs[3])
+	}
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"go-im/api/message"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/webhook"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxWebhookEvents          = 20
+	defaultWebhookDeliveryNum = 20
+	maxWebhookDeliveryNum     = 100
+)
+
+func (s *Server) CreateWebhook(ctx context.Context, in *message.CreateWebhookReq) (*message.CreateWebhookResp, error) {
+	if err := webhook.CheckURL(ctx, in.Url); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(errcode.ErrWebhookUrlInvalid)
+	}
+	if len(in.Events) > maxWebhookEvents {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	switch in.ScopeKind {
+	case webhook.ScopeGroup:
+		group, err := s.groupRepository.FindOne(ctx, in.ScopeId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errcode.ToRpcError(errcode.ErrGroupNoExists)
+			}
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if group.OwnerId != in.UserId {
+			return nil, errcode.ToRpcError(errcode.ErrGroupOwnerOnly)
+		}
+	case webhook.ScopeUser:
+		// 用户范围只能订阅自己创建的机器人，不能订阅自己或他人的私聊
+		ctx, cancel := context.WithTimeout(ctx, userRpcTimeout)
+		defer cancel()
+		info, err := s.userRpc.UserInfo(ctx, &user.UserInfoReq{UserId: in.ScopeId})
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, err
+		}
+		if !info.IsBot || info.OwnerId != in.UserId {
+			return nil, errcode.ToRpcError(errcode.ErrForbidden)
+		}
+	default:
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	sub := &webhook.Subscription{
+		OwnerId:   in.UserId,
+		ScopeKind: in.ScopeKind,
+		ScopeId:   in.ScopeId,
+		Url:       in.Url,
+		Events:    strings.Join(in.Events, ","),
+	}
+	if err := s.webhook.Subscribe(ctx, sub); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.CreateWebhookResp{
+		Id:     sub.ID,
+		Secret: sub.Secret,
+	}, nil
+}
+
+func (s *Server) ListWebhook(ctx context.Context, in *message.ListWebhookReq) (*message.ListWebhookResp, error) {
+	list, err := s.webhook.ListSubscriptions(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &message.ListWebhookResp{
+		List: make([]*message.WebhookInfo, 0, len(list)),
+	}
+	for _, sub := range list {
+		info := &message.WebhookInfo{
+			Id:         sub.ID,
+			ScopeKind:  sub.ScopeKind,
+			ScopeId:    sub.ScopeId,
+			Url:        sub.Url,
+			CreateTime: sub.CreatedAt.UnixMilli(),
+		}
+		if sub.Events != "" {
+			info.Events = strings.Split(sub.Events, ",")
+		}
+		resp.List = append(resp.List, info)
+	}
+	return resp, nil
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, in *message.DeleteWebhookReq) (*message.DeleteWebhookResp, error) {
+	if _, err := s.ownWebhook(ctx, in.UserId, in.Id); err != nil {
+		return nil, err
+	}
+	if err := s.webhook.Unsubscribe(ctx, in.Id); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &message.DeleteWebhookResp{}, nil
+}
+
+func (s *Server) ListWebhookDelivery(ctx context.Context, in *message.ListWebhookDeliveryReq) (*message.ListWebhookDeliveryResp, error) {
+	if _, err := s.ownWebhook(ctx, in.UserId, in.Id); err != nil {
+		return nil, err
+	}
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultWebhookDeliveryNum
+	}
+	limit = min(limit, maxWebhookDeliveryNum)
+	list, err := s.webhook.ListAttempts(ctx, in.Id, limit)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &message.ListWebhookDeliveryResp{
+		List: make([]*message.WebhookDelivery, 0, len(list)),
+	}
+	for _, a := range list {
+		resp.List = append(resp.List, &message.WebhookDelivery{
+			Id:         a.DeliveryId,
+			EventId:    a.EventId,
+			EventType:  a.EventType,
+			Attempt:    int32(a.Attempt),
+			StatusCode: int32(a.StatusCode),
+			Error:      a.Error,
+			DurationMs: a.DurationMs,
+			Result:     a.Result,
+			CreateTime: a.CreatedAt.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// ownWebhook 只有创建者可以管理订阅
+func (s *Server) ownWebhook(ctx context.Context, userId int64, id int64) (*webhook.Subscription, error) {
+	sub, err := s.webhook.FindSubscription(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrWebhookNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if sub.OwnerId != userId {
+		return nil, errcode.ToRpcError(errcode.ErrWebhookNotExists)
+	}
+	return sub, nil
+}
@@ -99,7 +99,7 @@ func (db *DB) Wrap(ctx context.Context, name string, f func(tx *gorm.DB) *gorm.D
 	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
 		return f(tx)
 	})
-	stmt := f(db.DB)
+	stmt := f(db.Conn(ctx))
 	span.SetAttributes(mtrace.SQLKey.String(sql))
 	if stmt.Error != nil {
 		span.SetAttributes(mtrace.SQLError.String(stmt.Error.Error()))
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+type txState struct {
+	tx          *gorm.DB
+	afterCommit []func()
+}
+
+// InTx 开启事务并放入 ctx，使用 Wrap 或 Conn 的仓储方法会自动加入该事务
+// ctx 中已有事务时直接复用，不再嵌套
+func (db *DB) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+	st := &txState{}
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		st.tx = tx
+		return fn(context.WithValue(ctx, txKey{}, st))
+	})
+	if err != nil {
+		return err
+	}
+	for _, f := range st.afterCommit {
+		f()
+	}
+	return nil
+}
+
+// Conn 返回 ctx 中的事务，没有时返回普通连接
+func (db *DB) Conn(ctx context.Context) *gorm.DB {
+	if st, ok := ctx.Value(txKey{}).(*txState); ok {
+		return st.tx
+	}
+	return db.DB
+}
+
+// AfterCommit 在事务提交后执行 f，事务回滚时丢弃；不在事务中时立即执行
+func AfterCommit(ctx context.Context, f func()) {
+	if st, ok := ctx.Value(txKey{}).(*txState); ok {
+		st.afterCommit = append(st.afterCommit, f)
+		return
+	}
+	f()
+}
@@ -80,6 +80,21 @@ func NewGroupReader(c Config, groupId string, topic string, opts ...ReaderOption
 	return r
 }
 
+// NewLatestGroupReader 新建的消费组从最新位置开始消费，用于每个实例独占一个消费组的场景
+func NewLatestGroupReader(c Config, groupId string, topic string, opts ...ReaderOption) *kafka.Reader {
+	rc := kafka.ReaderConfig{
+		Brokers:     c.Brokers,
+		Topic:       topic,
+		GroupID:     groupId,
+		StartOffset: kafka.LastOffset,
+	}
+	r := kafka.NewReader(rc)
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 func NewReader(c Config, opts ...ReaderOption) *kafka.Reader {
 	rc := kafka.ReaderConfig{
 		Brokers: c.Brokers,
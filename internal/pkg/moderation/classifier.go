@@ -0,0 +1,86 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go-im/internal/pkg/mjson"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultClassifierTimeout = 500 * time.Millisecond
+
+type ClassifierConfig struct {
+	Url string `yaml:"url"`
+	// 单位毫秒，超时视为故障放行
+	Timeout int `yaml:"timeout"`
+}
+
+type classifyReq struct {
+	Text string `json:"text"`
+}
+
+type classifyResp struct {
+	Action Action `json:"action"`
+	// mask 时返回打码后的文本
+	Text   string `json:"text"`
+	Reason string `json:"reason"`
+}
+
+// Classifier 调用外部分类服务判断文本
+type Classifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewClassifier(cfg ClassifierConfig) *Classifier {
+	timeout := time.Duration(cfg.Timeout) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultClassifierTimeout
+	}
+	return &Classifier{
+		url:    cfg.Url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *Classifier) Name() string {
+	return "classifier"
+}
+
+func (c *Classifier) Check(ctx context.Context, text string) (*Decision, error) {
+	b, _ := mjson.Marshal(&classifyReq{Text: text})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	var out classifyResp
+	if err := mjson.Unmarshal(body, &out); err != nil {
+		return nil, err
+	}
+	switch out.Action {
+	case Allow, Block:
+		return &Decision{Action: out.Action, Reason: out.Reason}, nil
+	case Mask:
+		if out.Text == "" {
+			return nil, fmt.Errorf("mask without text")
+		}
+		return &Decision{Action: Mask, Text: out.Text, Reason: out.Reason}, nil
+	}
+	return nil, fmt.Errorf("unknown action %q", out.Action)
+}
@@ -0,0 +1,166 @@
+package moderation
+
+import (
+	"context"
+	"go-im/internal/common/errcode"
+	"go-im/internal/pkg/db"
+	"go-im/internal/pkg/log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Action string
+
+const (
+	Allow Action = "allow"
+	// 命中部分替换为 *
+	Mask  Action = "mask"
+	Block Action = "block"
+)
+
+const (
+	SceneMessage   = "message"
+	SceneUsername  = "username"
+	SceneGroupName = "group_name"
+
+	maxLogContent = 500
+)
+
+type Config struct {
+	// 敏感词词典文件，每行一个词，以 ! 开头的词直接拦截，其余打码
+	Dictionary string `yaml:"dictionary"`
+	// 词典热加载检查间隔，单位秒，0 表示不检查
+	ReloadInterval int `yaml:"reload_interval"`
+	// 正则规则，按顺序匹配
+	Patterns []PatternRule `yaml:"patterns"`
+	// 禁止出现的链接域名，包含其子域名
+	BlockedDomains []string `yaml:"blocked_domains"`
+	// 外部分类服务，url 为空时不启用
+	Classifier ClassifierConfig `yaml:"classifier"`
+}
+
+// Decision 过滤器对一段文本的处理结果
+type Decision struct {
+	Action Action
+	// 打码后的文本，仅 Mask 时有效
+	Text string
+	// 拦截时返回给调用方的错误，为空时使用 ErrContentBlocked
+	Err    *errcode.Error
+	Reason string
+}
+
+type Filter interface {
+	Name() string
+	Check(ctx context.Context, text string) (*Decision, error)
+}
+
+// Log 打码和拦截记录，供人工复核
+type Log struct {
+	ID      int64  `gorm:"id" json:"id"`
+	Source  string `gorm:"source" json:"source"`
+	Scene   string `gorm:"scene" json:"scene"`
+	UserId  int64  `gorm:"user_id" json:"user_id"`
+	Filter  string `gorm:"filter" json:"filter"`
+	Action  Action `gorm:"action" json:"action"`
+	Reason  string `gorm:"reason" json:"reason"`
+	Content string `gorm:"content" json:"content"`
+	gorm.Model
+}
+
+func (l Log) TableName() string {
+	return "moderation_log"
+}
+
+// Moderator 按顺序执行过滤器，前一个打码的结果交给下一个继续检查
+type Moderator struct {
+	db      *db.DB
+	source  string
+	filters []Filter
+}
+
+// New 按配置组装过滤器，source 区分写入审核记录的服务
+func New(cfg Config, db *db.DB, source string) (*Moderator, error) {
+	m := &Moderator{db: db, source: source}
+	if cfg.Dictionary != "" {
+		f, err := NewWordFilter(cfg.Dictionary)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.ReloadInterval > 0 {
+			f.Watch(time.Duration(cfg.ReloadInterval) * time.Second)
+		}
+		m.filters = append(m.filters, f)
+	}
+	if len(cfg.Patterns) > 0 {
+		f, err := NewPatternFilter(cfg.Patterns)
+		if err != nil {
+			return nil, err
+		}
+		m.filters = append(m.filters, f)
+	}
+	if len(cfg.BlockedDomains) > 0 {
+		m.filters = append(m.filters, NewURLFilter(cfg.BlockedDomains))
+	}
+	if cfg.Classifier.Url != "" {
+		m.filters = append(m.filters, NewClassifier(cfg.Classifier))
+	}
+	return m, nil
+}
+
+// NewWithFilters 直接指定过滤器，db 为空时不写审核记录
+func NewWithFilters(db *db.DB, source string, filters ...Filter) *Moderator {
+	return &Moderator{db: db, source: source, filters: filters}
+}
+
+// Check 返回处理后的文本，被拦截时返回对应的错误码
+func (m *Moderator) Check(ctx context.Context, scene string, userId int64, text string) (string, error) {
+	if text == "" {
+		return text, nil
+	}
+	for _, f := range m.filters {
+		d, err := f.Check(ctx, text)
+		if err != nil {
+			// 过滤器自身故障时放行，避免影响正常收发
+			log.Errorf("moderation filter %s failed, err: %v", f.Name(), err)
+			continue
+		}
+		switch d.Action {
+		case Mask:
+			m.record(ctx, scene, userId, f.Name(), d, text)
+			text = d.Text
+		case Block:
+			m.record(ctx, scene, userId, f.Name(), d, text)
+			if d.Err != nil {
+				return "", d.Err
+			}
+			return "", errcode.ErrContentBlocked
+		}
+	}
+	return text, nil
+}
+
+func (m *Moderator) record(ctx context.Context, scene string, userId int64, filter string, d *Decision, text string) {
+	log.Infof("moderation %s: scene=%s user=%d filter=%s reason=%s", d.Action, scene, userId, filter, d.Reason)
+	if m.db == nil {
+		return
+	}
+	if r := []rune(text); len(r) > maxLogContent {
+		text = string(r[:maxLogContent])
+	}
+	l := &Log{
+		Source:  m.source,
+		Scene:   scene,
+		UserId:  userId,
+		Filter:  filter,
+		Action:  d.Action,
+		Reason:  d.Reason,
+		Content: text,
+	}
+	err := m.db.Wrap(ctx, "AddModerationLog", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(l)
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
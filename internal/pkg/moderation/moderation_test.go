@@ -0,0 +1,177 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"go-im/internal/common/errcode"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	log.InitLogger(log.Config{Level: "error"})
+	os.Exit(m.Run())
+}
+
+func TestWordFilter(t *testing.T) {
+	f, _ := NewWordFilter("")
+	f.Load([]string{"he", "she", "his", "hers", "坏人", "!炸弹"})
+	cases := []struct {
+		text   string
+		action Action
+		want   string
+	}{
+		{"hello world", Mask, "**llo world"},
+		{"ushers", Mask, "u*****"},
+		{"HIS cat", Mask, "*** cat"},
+		{"你是坏人吗", Mask, "你是**吗"},
+		{"nothing", Allow, ""},
+		{"这里有炸弹", Block, ""},
+	}
+	for _, c := range cases {
+		d, err := f.Check(context.Background(), c.text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Action != c.action || d.Text != c.want {
+			t.Fatalf("%q: got %s %q, want %s %q", c.text, d.Action, d.Text, c.action, c.want)
+		}
+	}
+}
+
+func TestWordFilterReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(path, []byte("# comment\nfoo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := NewWordFilter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, _ := f.Check(context.Background(), "foo bar"); d.Action != Mask {
+		t.Fatalf("expected mask, got %s", d.Action)
+	}
+	if err := os.WriteFile(path, []byte("!bar\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// 保证修改时间变化
+	later := time.Now().Add(time.Second)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if d, _ := f.Check(context.Background(), "foo bar"); d.Action != Block {
+		t.Fatalf("expected block after reload, got %s", d.Action)
+	}
+}
+
+func TestPatternFilter(t *testing.T) {
+	f, err := NewPatternFilter([]PatternRule{
+		{Pattern: `1[3-9]\d{9}`, Action: Mask},
+		{Pattern: `(?i)buy\s+followers`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, _ := f.Check(context.Background(), "call 13812345678 now")
+	if d.Action != Mask || d.Text != "call *********** now" {
+		t.Fatalf("unexpected %s %q", d.Action, d.Text)
+	}
+	d, _ = f.Check(context.Background(), "Buy  followers cheap")
+	if d.Action != Block {
+		t.Fatalf("expected block, got %s", d.Action)
+	}
+	if _, err := NewPatternFilter([]PatternRule{{Pattern: "("}}); err == nil {
+		t.Fatal("expected compile error")
+	}
+}
+
+func TestURLFilter(t *testing.T) {
+	f := NewURLFilter([]string{"bad.com"})
+	for _, text := range []string{"see https://bad.com/x", "go to WWW.Bad.Com", "x.bad.com is here"} {
+		d, _ := f.Check(context.Background(), text)
+		if d.Action != Block || d.Err != errcode.ErrContentUrlBlocked {
+			t.Fatalf("%q: expected block, got %s", text, d.Action)
+		}
+	}
+	for _, text := range []string{"notbad.com", "bad.company.org", "plain text"} {
+		if d, _ := f.Check(context.Background(), text); d.Action != Allow {
+			t.Fatalf("%q: expected allow, got %s", text, d.Action)
+		}
+	}
+}
+
+func TestClassifier(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req classifyReq
+		body, _ := io.ReadAll(r.Body)
+		_ = mjson.Unmarshal(body, &req)
+		resp := classifyResp{Action: Allow}
+		switch {
+		case strings.Contains(req.Text, "spam"):
+			resp = classifyResp{Action: Block, Reason: "spam"}
+		case strings.Contains(req.Text, "rude"):
+			resp = classifyResp{Action: Mask, Text: strings.ReplaceAll(req.Text, "rude", "****")}
+		case strings.Contains(req.Text, "slow"):
+			time.Sleep(200 * time.Millisecond)
+		}
+		b, _ := mjson.Marshal(&resp)
+		w.Write(b)
+	}))
+	defer srv.Close()
+	c := NewClassifier(ClassifierConfig{Url: srv.URL, Timeout: 100})
+	if d, err := c.Check(context.Background(), "spam spam"); err != nil || d.Action != Block {
+		t.Fatalf("expected block, got %v, %v", d, err)
+	}
+	if d, err := c.Check(context.Background(), "so rude"); err != nil || d.Text != "so ****" {
+		t.Fatalf("expected mask, got %v, %v", d, err)
+	}
+	if _, err := c.Check(context.Background(), "slow"); err == nil {
+		t.Fatal("expected timeout")
+	}
+}
+
+type stubFilter struct {
+	d   *Decision
+	err error
+}
+
+func (f stubFilter) Name() string {
+	return "stub"
+}
+
+func (f stubFilter) Check(ctx context.Context, text string) (*Decision, error) {
+	return f.d, f.err
+}
+
+func TestModerator(t *testing.T) {
+	words, _ := NewWordFilter("")
+	words.Load([]string{"bad"})
+	m := NewWithFilters(nil, "test",
+		stubFilter{err: errors.New("down")},
+		words,
+		NewURLFilter([]string{"evil.org"}),
+	)
+	text, err := m.Check(context.Background(), SceneMessage, 1, "bad day")
+	if err != nil || text != "*** day" {
+		t.Fatalf("unexpected %q, %v", text, err)
+	}
+	_, err = m.Check(context.Background(), SceneMessage, 1, "visit evil.org")
+	if err != errcode.ErrContentUrlBlocked {
+		t.Fatalf("expected url blocked, got %v", err)
+	}
+	m = NewWithFilters(nil, "test", stubFilter{d: &Decision{Action: Block}})
+	if _, err := m.Check(context.Background(), SceneUsername, 1, "x"); err != errcode.ErrContentBlocked {
+		t.Fatalf("expected default block error, got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package moderation
+
+import (
+	"context"
+	"go-im/internal/common/errcode"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+type PatternRule struct {
+	Pattern string `yaml:"pattern"`
+	// mask 或 block，默认 block
+	Action Action `yaml:"action"`
+}
+
+type compiledRule struct {
+	re     *regexp.Regexp
+	action Action
+}
+
+// PatternFilter 正则规则过滤，先检查拦截规则再对打码规则逐个替换
+type PatternFilter struct {
+	rules []compiledRule
+}
+
+func NewPatternFilter(rules []PatternRule) (*PatternFilter, error) {
+	f := &PatternFilter{}
+	for _, rule := range rules {
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		action := rule.Action
+		if action != Mask {
+			action = Block
+		}
+		f.rules = append(f.rules, compiledRule{re: re, action: action})
+	}
+	return f, nil
+}
+
+func (f *PatternFilter) Name() string {
+	return "pattern"
+}
+
+func (f *PatternFilter) Check(ctx context.Context, text string) (*Decision, error) {
+	for _, rule := range f.rules {
+		if rule.action == Block && rule.re.MatchString(text) {
+			return &Decision{Action: Block, Reason: rule.re.String()}, nil
+		}
+	}
+	masked := text
+	var reasons []string
+	for _, rule := range f.rules {
+		if rule.action != Mask || !rule.re.MatchString(masked) {
+			continue
+		}
+		masked = rule.re.ReplaceAllStringFunc(masked, func(s string) string {
+			return strings.Repeat("*", utf8.RuneCountInString(s))
+		})
+		reasons = append(reasons, rule.re.String())
+	}
+	if len(reasons) == 0 {
+		return &Decision{Action: Allow}, nil
+	}
+	return &Decision{Action: Mask, Text: masked, Reason: strings.Join(reasons, ",")}, nil
+}
+
+// 链接或裸域名，只取到主机名部分
+var urlRe = regexp.MustCompile(`(?i)(?:https?://)?(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}`)
+
+// URLFilter 文本中出现被禁止域名的链接时拦截
+type URLFilter struct {
+	domains []string
+}
+
+func NewURLFilter(domains []string) *URLFilter {
+	f := &URLFilter{}
+	for _, d := range domains {
+		d = strings.ToLower(strings.Trim(strings.TrimSpace(d), "."))
+		if d != "" {
+			f.domains = append(f.domains, d)
+		}
+	}
+	return f
+}
+
+func (f *URLFilter) Name() string {
+	return "url"
+}
+
+func (f *URLFilter) Check(ctx context.Context, text string) (*Decision, error) {
+	for _, loc := range urlRe.FindAllStringIndex(text, -1) {
+		host := strings.ToLower(text[loc[0]:loc[1]])
+		if i := strings.Index(host, "://"); i >= 0 {
+			host = host[i+3:]
+		}
+		for _, d := range f.domains {
+			if host == d || strings.HasSuffix(host, "."+d) {
+				return &Decision{Action: Block, Err: errcode.ErrContentUrlBlocked, Reason: d}, nil
+			}
+		}
+	}
+	return &Decision{Action: Allow}, nil
+}
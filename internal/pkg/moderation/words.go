@@ -0,0 +1,218 @@
+package moderation
+
+import (
+	"bufio"
+	"context"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/utils"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+)
+
+// acNode Aho-Corasick 自动机节点
+type acNode struct {
+	next map[rune]int32
+	fail int32
+	// 以该节点结尾的最长词的长度（按 rune 计），沿失败指针合并，0 表示没有词在此结尾
+	length int
+	// 以该节点结尾的词中是否有需要拦截的
+	block bool
+}
+
+type matcher struct {
+	nodes []acNode
+}
+
+// newMatcher words 为词到是否拦截的映射，匹配时忽略大小写
+func newMatcher(words map[string]bool) *matcher {
+	m := &matcher{nodes: []acNode{{next: map[rune]int32{}}}}
+	for word, block := range words {
+		cur := int32(0)
+		n := 0
+		for _, r := range word {
+			r = unicode.ToLower(r)
+			nx, ok := m.nodes[cur].next[r]
+			if !ok {
+				m.nodes = append(m.nodes, acNode{next: map[rune]int32{}})
+				nx = int32(len(m.nodes) - 1)
+				m.nodes[cur].next[r] = nx
+			}
+			cur = nx
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+		m.nodes[cur].length = n
+		m.nodes[cur].block = m.nodes[cur].block || block
+	}
+	// 按层构建失败指针，父节点先于子节点处理，合并结果可以直接沿用
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for {
+				if nx, ok := m.nodes[f].next[r]; ok && nx != child {
+					m.nodes[child].fail = nx
+					break
+				}
+				if f == 0 {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			fail := &m.nodes[m.nodes[child].fail]
+			m.nodes[child].length = max(m.nodes[child].length, fail.length)
+			m.nodes[child].block = m.nodes[child].block || fail.block
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// match 返回需要打码的位置和是否命中拦截词
+func (m *matcher) match(text []rune) ([]bool, bool) {
+	var hits []bool
+	block := false
+	cur := int32(0)
+	for i, r := range text {
+		r = unicode.ToLower(r)
+		for {
+			if nx, ok := m.nodes[cur].next[r]; ok {
+				cur = nx
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		node := &m.nodes[cur]
+		if node.length == 0 {
+			continue
+		}
+		if node.block {
+			block = true
+		}
+		if hits == nil {
+			hits = make([]bool, len(text))
+		}
+		for j := i - node.length + 1; j <= i; j++ {
+			hits[j] = true
+		}
+	}
+	return hits, block
+}
+
+// WordFilter 敏感词过滤，词典可在运行时替换
+type WordFilter struct {
+	path    string
+	modTime time.Time
+	m       atomic.Pointer[matcher]
+}
+
+// NewWordFilter 从词典文件加载，path 为空时词典为空，可通过 Load 设置
+func NewWordFilter(path string) (*WordFilter, error) {
+	f := &WordFilter{path: path}
+	f.Load(nil)
+	if path != "" {
+		if err := f.Reload(); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func (f *WordFilter) Name() string {
+	return "words"
+}
+
+// Load 用给定的词替换当前词典，以 ! 开头的词命中时拦截
+func (f *WordFilter) Load(words []string) {
+	dict := make(map[string]bool, len(words))
+	for _, w := range words {
+		block := strings.HasPrefix(w, "!")
+		w = strings.TrimSpace(strings.TrimPrefix(w, "!"))
+		if w != "" {
+			dict[w] = dict[w] || block
+		}
+	}
+	f.m.Store(newMatcher(dict))
+}
+
+// Reload 词典文件有变化时重新加载
+func (f *WordFilter) Reload() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return err
+	}
+	if info.ModTime().Equal(f.modTime) {
+		return nil
+	}
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	words, err := readDictionary(file)
+	if err != nil {
+		return err
+	}
+	f.Load(words)
+	f.modTime = info.ModTime()
+	log.Infof("moderation dictionary loaded, %d words", len(words))
+	return nil
+}
+
+// Watch 定期检查词典文件，加载失败时保留旧词典
+func (f *WordFilter) Watch(interval time.Duration) {
+	utils.SafeGo(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := f.Reload(); err != nil {
+				log.Errorf("reload moderation dictionary failed, err: %v", err)
+			}
+		}
+	})
+}
+
+func (f *WordFilter) Check(ctx context.Context, text string) (*Decision, error) {
+	runes := []rune(text)
+	hits, block := f.m.Load().match(runes)
+	if block {
+		return &Decision{Action: Block, Reason: "sensitive word"}, nil
+	}
+	if hits == nil {
+		return &Decision{Action: Allow}, nil
+	}
+	for i, hit := range hits {
+		if hit {
+			runes[i] = '*'
+		}
+	}
+	return &Decision{Action: Mask, Text: string(runes), Reason: "sensitive word"}, nil
+}
+
+// readDictionary 每行一个词，忽略空行和 # 开头的注释
+func readDictionary(r io.Reader) ([]string, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words, scanner.Err()
+}
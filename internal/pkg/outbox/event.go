@@ -0,0 +1,39 @@
+package outbox
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	StatusPending = "pending"
+	StatusDone    = "done"
+	// 超过最大重试次数，需要人工处理
+	StatusDead = "dead"
+)
+
+// Event 与业务数据在同一事务中写入的待推送事件
+type Event struct {
+	ID     int64  `gorm:"id" json:"id"`
+	Source string `gorm:"source" json:"source"`
+	Topic  string `gorm:"topic" json:"topic"`
+	// key 是 MySQL 保留字
+	Key       []byte    `gorm:"column:msg_key" json:"key"`
+	Body      []byte    `gorm:"body" json:"body"`
+	Status    string    `gorm:"status" json:"status"`
+	Attempts  int       `gorm:"attempts" json:"attempts"`
+	NextAt    time.Time `gorm:"next_at" json:"next_at"`
+	LastError string    `gorm:"last_error" json:"last_error"`
+	gorm.Model
+}
+
+func (e Event) TableName() string {
+	return "outbox"
+}
+
+// EventId 全局唯一的事件ID，消费端据此去重
+func (e *Event) EventId() string {
+	return fmt.Sprintf("%s-%d", e.Source, e.ID)
+}
@@ -0,0 +1,145 @@
+package outbox
+
+import (
+	"context"
+	"go-im/internal/pkg/db"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/redis"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	pollBatch = 100
+
+	maxAttempts    = 16
+	publishTimeout = 5 * time.Second
+	maxBackoff     = 5 * time.Minute
+
+	retention     = 24 * time.Hour
+	purgeInterval = 10 * time.Minute
+)
+
+// Publisher 把事件发送到 Kafka 或接入层，返回 nil 表示对方已接收
+type Publisher func(ctx context.Context, e *Event) error
+
+type Outbox struct {
+	db        *db.DB
+	source    string
+	publish   Publisher
+	relay     *Relay[*Event]
+	lastPurge time.Time
+}
+
+// New source 区分写入方，同一张表可由多个服务共用
+func New(db *db.DB, r *redis.Redis, source string, publish Publisher) *Outbox {
+	o := &Outbox{
+		db:      db,
+		source:  source,
+		publish: publish,
+	}
+	// 单个协程投递，保持同一副本内事件的发送顺序
+	o.relay = NewRelay(r, "outbox:relay:"+source, 1, pollBatch, o.listDue, func(e *Event) int64 { return e.ID }, o.deliver)
+	o.relay.OnScan = o.purge
+	return o
+}
+
+// Add 写入事件，ctx 中有事务时随事务提交，提交后立即投递
+func (o *Outbox) Add(ctx context.Context, events ...*Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	nextAt := time.Now().Add(GraceDelay)
+	for _, e := range events {
+		e.Source = o.source
+		e.Status = StatusPending
+		e.NextAt = nextAt
+	}
+	err := o.db.Wrap(ctx, "AddOutbox", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(events)
+	})
+	if err != nil {
+		return errors.Wrap(err, "AddOutbox")
+	}
+	db.AfterCommit(ctx, func() {
+		o.relay.Push(events...)
+	})
+	return nil
+}
+
+// Run 启动投递协程和补偿扫描
+func (o *Outbox) Run() {
+	o.relay.Run()
+}
+
+func (o *Outbox) listDue(ctx context.Context, afterId int64, limit int) ([]*Event, error) {
+	var list []*Event
+	err := o.db.Wrap(ctx, "ListOutbox", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("source=? AND status=? AND next_at<=? AND id>?", o.source, StatusPending, time.Now(), afterId).
+			Order("id ASC").Limit(limit).Find(&list)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListOutbox")
+	}
+	return list, nil
+}
+
+func (o *Outbox) deliver(ctx context.Context, e *Event) {
+	pctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	err := o.publish(pctx, e)
+	cancel()
+	if err == nil {
+		err = o.db.Wrap(ctx, "DoneOutbox", func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&Event{}).Where("id=? AND status=?", e.ID, StatusPending).Update("status", StatusDone)
+		})
+		if err != nil {
+			// 消费端按事件ID去重，重复投递无害
+			log.Errorf("err: %v", err)
+		}
+		return
+	}
+	log.Errorf("publish outbox event %s failed, attempts: %d, err: %v", e.EventId(), e.Attempts+1, err)
+	e.Attempts++
+	values := map[string]any{
+		"attempts":   e.Attempts,
+		"next_at":    time.Now().Add(Backoff(e.Attempts)),
+		"last_error": Truncate(err.Error(), 255),
+	}
+	if e.Attempts >= maxAttempts {
+		values["status"] = StatusDead
+	}
+	err = o.db.Wrap(ctx, "RetryOutbox", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&Event{}).Where("id=? AND status=?", e.ID, StatusPending).Updates(values)
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// purge 只在扫描副本上执行，间隔 purgeInterval
+func (o *Outbox) purge(ctx context.Context) {
+	if time.Since(o.lastPurge) < purgeInterval {
+		return
+	}
+	o.lastPurge = time.Now()
+	err := o.db.Wrap(ctx, "PurgeOutbox", func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Where("source=? AND status=? AND updated_at<?", o.source, StatusDone, time.Now().Add(-retention)).
+			Delete(&Event{})
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// Backoff 第 n 次失败后的等待时间，按 2 的指数增长并封顶
+func Backoff(attempts int) time.Duration {
+	if attempts <= 0 {
+		return time.Second
+	}
+	if attempts > 16 {
+		return maxBackoff
+	}
+	return min(time.Second<<(attempts-1), maxBackoff)
+}
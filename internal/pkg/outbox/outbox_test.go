@@ -0,0 +1,30 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		0:   time.Second,
+		1:   time.Second,
+		2:   2 * time.Second,
+		5:   16 * time.Second,
+		9:   256 * time.Second,
+		10:  maxBackoff,
+		100: maxBackoff,
+	}
+	for attempts, want := range cases {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+func TestEventId(t *testing.T) {
+	e := &Event{ID: 42, Source: "message"}
+	if got := e.EventId(); got != "message-42" {
+		t.Fatalf("unexpected event id %q", got)
+	}
+}
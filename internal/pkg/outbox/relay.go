@@ -0,0 +1,137 @@
+package outbox
+
+import (
+	"context"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/redis"
+	"go-im/internal/pkg/utils"
+	"sync"
+	"time"
+)
+
+const (
+	// GraceDelay 提交后由写入方立即投递，超过该时间仍未完成再由 relay 补偿
+	GraceDelay = 5 * time.Second
+
+	leaseTTL     = 10 * time.Second
+	pollInterval = time.Second
+	queueSize    = 2000
+)
+
+// Relay 投递队列和补偿扫描，outbox 事件和 webhook 投递共用。
+// 提交后的任务放入内存队列立即投递，队列满或副本退出时遗留的任务到期后由持有租约的副本扫描补偿
+type Relay[T any] struct {
+	lease   *redis.Lease
+	ch      chan T
+	workers int
+	batch   int
+	list    func(ctx context.Context, afterId int64, limit int) ([]T, error)
+	id      func(item T) int64
+	deliver func(ctx context.Context, item T)
+	// OnScan 持有租约时每轮扫描结束后调用
+	OnScan func(ctx context.Context)
+}
+
+// NewRelay list 按ID升序返回 afterId 之后到期未完成的任务，id 取任务ID，workers 为并发投递数
+func NewRelay[T any](r *redis.Redis, leaseKey string, workers int, batch int,
+	list func(ctx context.Context, afterId int64, limit int) ([]T, error), id func(item T) int64,
+	deliver func(ctx context.Context, item T)) *Relay[T] {
+	return &Relay[T]{
+		lease:   redis.NewLease(r, leaseKey, leaseTTL),
+		ch:      make(chan T, queueSize),
+		workers: workers,
+		batch:   batch,
+		list:    list,
+		id:      id,
+		deliver: deliver,
+	}
+}
+
+// Push 不阻塞，队列满时交给补偿扫描
+func (r *Relay[T]) Push(items ...T) {
+	for _, item := range items {
+		select {
+		case r.ch <- item:
+		default:
+		}
+	}
+}
+
+// Run 启动投递协程和补偿扫描
+func (r *Relay[T]) Run() {
+	for range r.workers {
+		utils.SafeGo(func() {
+			for item := range r.ch {
+				r.deliver(context.Background(), item)
+			}
+		})
+	}
+	utils.SafeGo(r.scan)
+}
+
+// scan 只有持有租约的副本扫描到期未完成的任务
+func (r *Relay[T]) scan() {
+	t := time.NewTicker(pollInterval)
+	defer t.Stop()
+	for range t.C {
+		ctx := context.Background()
+		ok, err := r.lease.Acquire(ctx)
+		if err != nil {
+			log.Errorf("acquire relay lease failed, err: %v", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		// 按ID游标前进，投递失败仍然到期的任务留到下一轮，避免同一批反复重扫
+		var cursor int64
+		for {
+			list, err := r.list(ctx, cursor, r.batch)
+			if err != nil {
+				log.Errorf("err: %v", err)
+				break
+			}
+			r.deliverAll(ctx, list)
+			if len(list) < r.batch {
+				break
+			}
+			cursor = r.id(list[len(list)-1])
+		}
+		if r.OnScan != nil {
+			r.OnScan(ctx)
+		}
+	}
+}
+
+// deliverAll 并发投递一批任务，全部完成后再扫描下一批，避免重复取到投递中的任务
+func (r *Relay[T]) deliverAll(ctx context.Context, list []T) {
+	if r.workers <= 1 {
+		for _, item := range list {
+			r.deliver(ctx, item)
+		}
+		return
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, r.workers)
+	for _, item := range list {
+		sem <- struct{}{}
+		wg.Add(1)
+		utils.SafeGo(func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			r.deliver(ctx, item)
+		})
+	}
+	wg.Wait()
+}
+
+// Truncate 按字符截断，用于写入有长度限制的错误信息列
+func Truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
@@ -0,0 +1,47 @@
+package push
+
+import (
+	"context"
+	"go-im/internal/pkg/log"
+	"sync"
+)
+
+// Fake 只记录并打印通知，用于本地开发和测试
+type Fake struct {
+	mu   sync.Mutex
+	sent []Notification
+	// 这些令牌返回 ErrInvalidToken
+	invalid map[string]bool
+}
+
+func NewFake() *Fake {
+	return &Fake{invalid: make(map[string]bool)}
+}
+
+func (f *Fake) Name() string {
+	return ProviderFake
+}
+
+func (f *Fake) Send(ctx context.Context, n *Notification) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.invalid[n.Token] {
+		return ErrInvalidToken
+	}
+	f.sent = append(f.sent, *n)
+	log.Infof("push to %s %s: %s %s", n.Platform, n.Token, n.Title, n.Body)
+	return nil
+}
+
+// Invalidate 之后发往该令牌的通知返回 ErrInvalidToken
+func (f *Fake) Invalidate(token string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.invalid[token] = true
+}
+
+func (f *Fake) Sent() []Notification {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Notification(nil), f.sent...)
+}
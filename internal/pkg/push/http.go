@@ -0,0 +1,80 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go-im/internal/pkg/mjson"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultTimeout = 3 * time.Second
+
+type httpMessage struct {
+	Platform     string            `json:"platform"`
+	Token        string            `json:"token"`
+	Notification httpNotification  `json:"notification"`
+	CollapseKey  string            `json:"collapse_key,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type httpNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// HTTP 按 APNs/FCM 网关的形式以 JSON 投递，404 和 410 表示令牌失效
+type HTTP struct {
+	url     string
+	authKey string
+	client  *http.Client
+}
+
+func NewHTTP(cfg Config) *HTTP {
+	timeout := time.Duration(cfg.Timeout) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &HTTP{
+		url:     cfg.Url,
+		authKey: cfg.AuthKey,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (h *HTTP) Name() string {
+	return ProviderHTTP
+}
+
+func (h *HTTP) Send(ctx context.Context, n *Notification) error {
+	b, _ := mjson.Marshal(&httpMessage{
+		Platform:     n.Platform,
+		Token:        n.Token,
+		Notification: httpNotification{Title: n.Title, Body: n.Body},
+		CollapseKey:  n.CollapseKey,
+		Data:         n.Data,
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.authKey != "" {
+		req.Header.Set("Authorization", "Bearer "+h.authKey)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrInvalidToken
+	}
+	return fmt.Errorf("unexpected status %d", resp.StatusCode)
+}
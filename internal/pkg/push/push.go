@@ -0,0 +1,121 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+
+	ProviderHTTP = "http"
+	ProviderFake = "fake"
+
+	// full: 显示发送者和内容；sender: 只显示发送者；hidden: 只提示有新消息
+	PreviewFull   = "full"
+	PreviewSender = "sender"
+	PreviewHidden = "hidden"
+)
+
+// ErrInvalidToken 设备令牌已失效，调用方应删除该令牌
+var ErrInvalidToken = errors.New("invalid device token")
+
+type Notification struct {
+	Platform string
+	Token    string
+	Title    string
+	Body     string
+	// 相同 CollapseKey 的通知在设备上只保留最新一条
+	CollapseKey string
+	Data        map[string]string
+}
+
+// Provider 推送通道，APNs/FCM 或本地调试用的 Fake
+type Provider interface {
+	Name() string
+	Send(ctx context.Context, n *Notification) error
+}
+
+type Config struct {
+	// http 或 fake，为空时使用 fake
+	Provider string `yaml:"provider"`
+	Url      string `yaml:"url"`
+	AuthKey  string `yaml:"auth_key"`
+	// 单位毫秒
+	Timeout int `yaml:"timeout"`
+	// 同一会话的推送合并窗口，单位秒
+	CollapseWindow int `yaml:"collapse_window"`
+}
+
+func New(cfg Config) (Provider, error) {
+	switch cfg.Provider {
+	case "", ProviderFake:
+		return NewFake(), nil
+	case ProviderHTTP:
+		if cfg.Url == "" {
+			return nil, errors.New("push url is empty")
+		}
+		return NewHTTP(cfg), nil
+	}
+	return nil, fmt.Errorf("unknown push provider %q", cfg.Provider)
+}
+
+// QuietHours 免打扰时段，按用户所在时区的分钟数表示，Start 大于 End 时跨越零点
+type QuietHours struct {
+	Start int
+	End   int
+}
+
+// ParseQuietHours 解析 "22:00-07:00"，空字符串表示未设置
+func ParseQuietHours(s string) (*QuietHours, error) {
+	if s == "" {
+		return nil, nil
+	}
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return nil, fmt.Errorf("invalid quiet hours %q", s)
+	}
+	a, err := parseClock(start)
+	if err != nil {
+		return nil, err
+	}
+	b, err := parseClock(end)
+	if err != nil {
+		return nil, err
+	}
+	if a == b {
+		return nil, fmt.Errorf("invalid quiet hours %q", s)
+	}
+	return &QuietHours{Start: a, End: b}, nil
+}
+
+func parseClock(s string) (int, error) {
+	hh, mm, ok := strings.Cut(s, ":")
+	if !ok || len(hh) != 2 || len(mm) != 2 {
+		return 0, fmt.Errorf("invalid clock %q", s)
+	}
+	h, err1 := strconv.Atoi(hh)
+	m, err2 := strconv.Atoi(mm)
+	if err1 != nil || err2 != nil || h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, fmt.Errorf("invalid clock %q", s)
+	}
+	return h*60 + m, nil
+}
+
+// Contains offset 为用户时区相对 UTC 的分钟数
+func (q *QuietHours) Contains(t time.Time, offset int) bool {
+	if q == nil {
+		return false
+	}
+	t = t.UTC().Add(time.Duration(offset) * time.Minute)
+	m := t.Hour()*60 + t.Minute()
+	if q.Start < q.End {
+		return m >= q.Start && m < q.End
+	}
+	return m >= q.Start || m < q.End
+}
@@ -0,0 +1,97 @@
+package push
+
+import (
+	"context"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	log.InitLogger(log.Config{Level: "error"})
+	os.Exit(m.Run())
+}
+
+func TestQuietHours(t *testing.T) {
+	q, err := ParseQuietHours("22:00-07:30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// UTC+8
+	cases := []struct {
+		utc  string
+		want bool
+	}{
+		{"2024-01-01T13:59:00Z", false},
+		{"2024-01-01T14:00:00Z", true},
+		{"2024-01-01T20:00:00Z", true},
+		{"2024-01-01T23:29:00Z", true},
+		{"2024-01-01T23:30:00Z", false},
+	}
+	for _, c := range cases {
+		now, _ := time.Parse(time.RFC3339, c.utc)
+		if got := q.Contains(now, 480); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.utc, got, c.want)
+		}
+	}
+	day, _ := ParseQuietHours("12:00-13:00")
+	noon, _ := time.Parse(time.RFC3339, "2024-01-01T12:30:00Z")
+	if !day.Contains(noon, 0) || day.Contains(noon, 60) {
+		t.Fatal("unexpected same-day quiet hours result")
+	}
+	for _, s := range []string{"22:00", "25:00-07:00", "08:00-08:00", "a:b-c:d", "7:00-08:00"} {
+		if _, err := ParseQuietHours(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+	if q, err := ParseQuietHours(""); q != nil || err != nil || q.Contains(noon, 0) {
+		t.Fatal("empty quiet hours should never match")
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	var got httpMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = mjson.Unmarshal(body, &got)
+		if got.Token == "gone" {
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	defer srv.Close()
+	p := NewHTTP(Config{Url: srv.URL, AuthKey: "secret"})
+	err := p.Send(context.Background(), &Notification{Platform: PlatformIOS, Token: "t1", Title: "alice", Body: "hi", CollapseKey: "single:1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Token != "t1" || got.Notification.Body != "hi" || got.CollapseKey != "single:1" {
+		t.Fatalf("unexpected request %+v", got)
+	}
+	if err := p.Send(context.Background(), &Notification{Token: "gone"}); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+	if err := NewHTTP(Config{Url: srv.URL}).Send(context.Background(), &Notification{Token: "t1"}); err == nil {
+		t.Fatal("expected error without auth key")
+	}
+}
+
+func TestFake(t *testing.T) {
+	f := NewFake()
+	_ = f.Send(context.Background(), &Notification{Token: "a", Body: "1"})
+	f.Invalidate("b")
+	if err := f.Send(context.Background(), &Notification{Token: "b"}); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+	if sent := f.Sent(); len(sent) != 1 || sent[0].Body != "1" {
+		t.Fatalf("unexpected sent %v", sent)
+	}
+}
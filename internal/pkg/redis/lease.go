@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 仅持有者可以续期和释放
+var (
+	renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// Lease 多副本之间的互斥租约，持有者需要在 ttl 内反复调用 Acquire 续期
+type Lease struct {
+	r     *Redis
+	key   string
+	token string
+	ttl   time.Duration
+}
+
+func NewLease(r *Redis, key string, ttl time.Duration) *Lease {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return &Lease{
+		r:     r,
+		key:   key,
+		token: hex.EncodeToString(b),
+		ttl:   ttl,
+	}
+}
+
+// Acquire 获取或续期租约，返回当前是否持有
+func (l *Lease) Acquire(ctx context.Context) (bool, error) {
+	ret, err := l.r.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := renewScript.Run(ctx, l.r, []string{l.key}, l.token, l.ttl.Milliseconds())
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		return false, err
+	}
+	if n, _ := ret.(int64); n == 1 {
+		return true, nil
+	}
+	ret, err = l.r.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := l.r.SetNX(ctx, l.key, l.token, l.ttl)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		return false, err
+	}
+	return ret.(bool), nil
+}
+
+func (l *Lease) Release(ctx context.Context) error {
+	_, err := l.r.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := releaseScript.Run(ctx, l.r, []string{l.key}, l.token)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	return err
+}
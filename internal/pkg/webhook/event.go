@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"encoding/json"
+	"go-im/api/access"
+	"go-im/internal/common/protocol"
+	"go-im/internal/pkg/mjson"
+	"strconv"
+)
+
+const (
+	EventMessageSent      = "message.sent"
+	EventMessageDeleted   = "message.deleted"
+	EventMessageExpired   = "message.expired"
+	EventMessageMentioned = "message.mentioned"
+	EventMessageUpdated   = "message.updated"
+
+	// 卡片按钮回调，同步调用，不走投递队列
+	EventCardAction = "card.action"
+
+	EventMemberJoined       = "member.joined"
+	EventMemberLeft         = "member.left"
+	EventGroupUpdated       = "group.updated"
+	EventGroupDismissed     = "group.dismissed"
+	EventGroupApplied       = "group.applied"
+	EventGroupApplyHandled  = "group.apply_handled"
+	EventFriendApplied      = "friend.applied"
+	EventFriendApplyHandled = "friend.apply_handled"
+	EventUserUpdated        = "user.updated"
+)
+
+// Event 从推送事件中提取的 webhook 事件
+type Event struct {
+	Type string
+	// 群相关事件的群ID，匹配群范围的订阅
+	GroupId int64
+	// 事件涉及的用户，匹配用户范围的订阅
+	UserIds []int64
+	// 原始推送内容
+	Data []byte
+}
+
+// payload 发送给订阅方的请求体
+type payload struct {
+	Id        string          `json:"id"`
+	Type      string          `json:"type"`
+	GroupId   int64           `json:"group_id,omitempty"`
+	CreatedAt int64           `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// FromPush 把推送管道中的事件转换为 webhook 事件，不需要对外的返回 nil
+func FromPush(body protocol.PushBody) *Event {
+	contentType, _ := strconv.Atoi(string(body.Key))
+	switch body.Type {
+	case protocol.MessageTopic:
+		var msg access.MessageBody
+		if mjson.Unmarshal(body.Body, &msg) != nil {
+			return nil
+		}
+		// 隐藏名单只在服务端使用
+		msg.HiddenFor = nil
+		data, _ := mjson.Marshal(&msg)
+		if msg.Kind == "group" {
+			return &Event{Type: EventMessageSent, GroupId: msg.ToId, UserIds: []int64{msg.FromId}, Data: data}
+		}
+		return &Event{Type: EventMessageSent, UserIds: []int64{msg.FromId, msg.ToId}, Data: data}
+	case protocol.MessageEventTopic:
+		switch contentType {
+		case protocol.MentionMsg:
+			var msg access.MentionNotifyMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			return &Event{Type: EventMessageMentioned, GroupId: msg.GroupId, UserIds: []int64{msg.FromId}, Data: body.Body}
+		case protocol.MessageDeletedMsg:
+			var msg access.MessageDeletedMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			// 只删除自己那份不对外通知
+			if !msg.ForEveryone {
+				return nil
+			}
+			return messageEvent(EventMessageDeleted, msg.Kind, msg.FromId, msg.PeerId, body.Body)
+		case protocol.MessageExpiredMsg:
+			var msg access.MessageExpiredMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			return messageEvent(EventMessageExpired, msg.Kind, msg.FromId, msg.PeerId, body.Body)
+		case protocol.CardUpdatedMsg:
+			var msg access.CardUpdatedMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			return messageEvent(EventMessageUpdated, msg.Kind, msg.FromId, msg.PeerId, body.Body)
+		}
+	case protocol.GroupEventTopic:
+		switch contentType {
+		case protocol.GroupApplyMsg:
+			var msg access.GroupApplyMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			return &Event{Type: EventGroupApplied, GroupId: msg.GroupId, Data: body.Body}
+		case protocol.GroupAppluResultMsg:
+			var msg access.GroupApplyResponseMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			return &Event{Type: EventGroupApplyHandled, GroupId: msg.GroupId, UserIds: []int64{msg.UserId}, Data: body.Body}
+		case protocol.GroupInfoUpdatedMsg, protocol.GroupDismissMsg, protocol.GroupMemberChangeMsg:
+			var msg access.GroupUpdatedInfoMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			e := &Event{Type: EventGroupUpdated, GroupId: msg.GroupId, Data: body.Body}
+			switch {
+			case contentType == protocol.GroupDismissMsg:
+				e.Type = EventGroupDismissed
+			case len(msg.Joined) > 0:
+				e.Type, e.UserIds = EventMemberJoined, msg.Joined
+			case len(msg.Left) > 0:
+				e.Type, e.UserIds = EventMemberLeft, msg.Left
+			}
+			return e
+		}
+	case protocol.FriendEventTopic:
+		switch contentType {
+		case protocol.FriendApplyMsg:
+			var msg access.FriendApplyMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			return &Event{Type: EventFriendApplied, UserIds: []int64{msg.UserId}, Data: body.Body}
+		case protocol.FriendApplyResultMsg:
+			var msg access.FriendApplyResponseMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			return &Event{Type: EventFriendApplyHandled, UserIds: []int64{msg.UserId}, Data: body.Body}
+		case protocol.FriendInfoUpdatedMsg:
+			var msg access.FriendUpdatedInfoMsg
+			if mjson.Unmarshal(body.Body, &msg) != nil {
+				return nil
+			}
+			return &Event{Type: EventUserUpdated, UserIds: []int64{msg.FriendId}, Data: body.Body}
+		}
+	}
+	return nil
+}
+
+func messageEvent(eventType string, kind string, fromId int64, peerId int64, data []byte) *Event {
+	if kind == "group" {
+		return &Event{Type: eventType, GroupId: peerId, UserIds: []int64{fromId}, Data: data}
+	}
+	return &Event{Type: eventType, UserIds: []int64{fromId, peerId}, Data: data}
+}
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrAddressDenied 地址解析到内网、回环或链路本地地址
+var ErrAddressDenied = errors.New("webhook: address not allowed")
+
+// cgnat 运营商级 NAT 地址段，net.IP.IsPrivate 不包含
+var cgnat = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func allowedIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() && !ip.IsUnspecified() && !cgnat.Contains(ip)
+}
+
+// CheckURL 创建订阅时校验地址，主机解析出的所有地址都必须是公网地址
+func CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return errors.New("webhook: invalid url")
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if !allowedIP(addr.IP) {
+			return ErrAddressDenied
+		}
+	}
+	return nil
+}
+
+// newClient 连接时再校验一次实际拨号的地址，防止创建后域名被解析到内网
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: sendTimeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !allowedIP(ip) {
+				return ErrAddressDenied
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: sendTimeout,
+		// 不走代理，否则校验的是代理地址
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			MaxIdleConnsPerHost: 10,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: sendTimeout,
+		},
+		// 重定向同样经过拨号校验，限制跳转次数
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 3 {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ScopeGroup = "group"
+	ScopeUser  = "user"
+
+	StatusPending = "pending"
+	StatusDone    = "done"
+	// 超过最大重试次数，进入死信
+	StatusDead = "dead"
+)
+
+// Subscription 订阅某个群或某个机器人相关的事件
+type Subscription struct {
+	ID        int64  `gorm:"id" json:"id"`
+	OwnerId   int64  `gorm:"owner_id" json:"owner_id"`
+	ScopeKind string `gorm:"scope_kind" json:"scope_kind"`
+	ScopeId   int64  `gorm:"scope_id" json:"scope_id"`
+	Url       string `gorm:"url" json:"url"`
+	Secret    string `gorm:"secret" json:"-"`
+	// 逗号分隔的事件类型，为空表示全部
+	Events string `gorm:"events" json:"events"`
+	gorm.Model
+}
+
+func (s Subscription) TableName() string {
+	return "webhook_subscription"
+}
+
+func (s *Subscription) Accept(eventType string) bool {
+	return s.Events == "" || slices.Contains(strings.Split(s.Events, ","), eventType)
+}
+
+// Delivery 一个事件对一个订阅的投递任务
+type Delivery struct {
+	ID             int64     `gorm:"id" json:"id"`
+	Source         string    `gorm:"source" json:"source"`
+	SubscriptionId int64     `gorm:"subscription_id" json:"subscription_id"`
+	EventId        string    `gorm:"event_id" json:"event_id"`
+	EventType      string    `gorm:"event_type" json:"event_type"`
+	Payload        []byte    `gorm:"payload" json:"payload"`
+	Status         string    `gorm:"status" json:"status"`
+	Attempts       int       `gorm:"attempts" json:"attempts"`
+	NextAt         time.Time `gorm:"next_at" json:"next_at"`
+	LastError      string    `gorm:"last_error" json:"last_error"`
+	gorm.Model
+}
+
+func (d Delivery) TableName() string {
+	return "webhook_delivery"
+}
+
+// Attempt 每次 HTTP 请求的结果，用于排查投递问题
+type Attempt struct {
+	ID             int64  `gorm:"id" json:"id"`
+	DeliveryId     int64  `gorm:"delivery_id" json:"delivery_id"`
+	SubscriptionId int64  `gorm:"subscription_id" json:"subscription_id"`
+	EventId        string `gorm:"event_id" json:"event_id"`
+	EventType      string `gorm:"event_type" json:"event_type"`
+	Attempt        int    `gorm:"attempt" json:"attempt"`
+	StatusCode     int    `gorm:"status_code" json:"status_code"`
+	Error          string `gorm:"error" json:"error"`
+	DurationMs     int64  `gorm:"duration_ms" json:"duration_ms"`
+	// 本次请求后投递任务的状态
+	Result string `gorm:"result" json:"result"`
+	gorm.Model
+}
+
+func (a Attempt) TableName() string {
+	return "webhook_attempt"
+}
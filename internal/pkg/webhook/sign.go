@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderEventId   = "X-Webhook-Id"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Sign 对 "时间戳.请求体" 做 HMAC-SHA256，时间戳参与签名防止重放
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 供接收方校验签名
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Send 发送一次签名请求，返回状态码，非 2xx 视为失败
+func Send(ctx context.Context, client *http.Client, sub *Subscription, d *Delivery) (int, error) {
+	code, _, err := post(ctx, client, sub, d.EventType, d.EventId, d.Payload, 4096)
+	return code, err
+}
+
+// post 发送签名请求并读取至多 limit 字节的响应体
+func post(ctx context.Context, client *http.Client, sub *Subscription, eventType string, eventId string, body []byte, limit int64) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderEventId, eventId)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, ts, body))
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(io.LimitReader(resp.Body, limit))
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, b, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, b, nil
+}
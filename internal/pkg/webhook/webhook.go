@@ -0,0 +1,326 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"go-im/internal/pkg/db"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/mjson"
+	"go-im/internal/pkg/outbox"
+	"go-im/internal/pkg/redis"
+	"go-im/internal/pkg/utils"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	pollBatch       = 50
+	refreshInterval = 10 * time.Second
+
+	maxAttempts = 10
+	sendTimeout = 5 * time.Second
+	// 单个订阅响应慢时不阻塞其他订阅的投递
+	deliverWorkers = 8
+
+	maxCallResponse = 64 << 10
+)
+
+// ErrNoSubscriber 同步调用时没有可用的订阅
+var ErrNoSubscriber = errors.New("webhook: no subscriber")
+
+type scopeKey struct {
+	kind string
+	id   int64
+}
+
+// Webhook 把推送事件匹配到订阅并可靠地投递出去
+type Webhook struct {
+	db     *db.DB
+	source string
+	client *http.Client
+	relay  *outbox.Relay[*Delivery]
+
+	m    sync.RWMutex
+	subs map[scopeKey][]*Subscription
+}
+
+// New source 区分写入方，同一张表可由多个服务共用
+func New(db *db.DB, r *redis.Redis, source string) *Webhook {
+	w := &Webhook{
+		db:     db,
+		source: source,
+		client: newClient(),
+		subs:   make(map[scopeKey][]*Subscription),
+	}
+	w.relay = outbox.NewRelay(r, "webhook:relay:"+source, deliverWorkers, pollBatch, w.listDue,
+		func(d *Delivery) int64 { return d.ID }, w.deliver)
+	return w
+}
+
+// Enqueue 为匹配的订阅写入投递任务，ctx 中有事务时随事务提交
+func (w *Webhook) Enqueue(ctx context.Context, e *Event) error {
+	if e == nil {
+		return nil
+	}
+	matched := w.match(e)
+	if len(matched) == 0 {
+		return nil
+	}
+	eventId := newEventId()
+	b, _ := mjson.Marshal(&payload{
+		Id:        eventId,
+		Type:      e.Type,
+		GroupId:   e.GroupId,
+		CreatedAt: time.Now().UnixMilli(),
+		Data:      e.Data,
+	})
+	nextAt := time.Now().Add(outbox.GraceDelay)
+	deliveries := make([]*Delivery, 0, len(matched))
+	for _, sub := range matched {
+		deliveries = append(deliveries, &Delivery{
+			Source:         w.source,
+			SubscriptionId: sub.ID,
+			EventId:        eventId,
+			EventType:      e.Type,
+			Payload:        b,
+			Status:         StatusPending,
+			NextAt:         nextAt,
+		})
+	}
+	err := w.db.Wrap(ctx, "AddWebhookDelivery", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(deliveries)
+	})
+	if err != nil {
+		return errors.Wrap(err, "AddWebhookDelivery")
+	}
+	db.AfterCommit(ctx, func() {
+		w.relay.Push(deliveries...)
+	})
+	return nil
+}
+
+// Call 同步调用第一个匹配的订阅并返回响应体，超时由 ctx 控制
+func (w *Webhook) Call(ctx context.Context, e *Event) ([]byte, error) {
+	matched := w.match(e)
+	if len(matched) == 0 {
+		return nil, ErrNoSubscriber
+	}
+	eventId := newEventId()
+	b, _ := mjson.Marshal(&payload{
+		Id:        eventId,
+		Type:      e.Type,
+		GroupId:   e.GroupId,
+		CreatedAt: time.Now().UnixMilli(),
+		Data:      e.Data,
+	})
+	_, body, err := post(ctx, w.client, matched[0], e.Type, eventId, b, maxCallResponse)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (w *Webhook) match(e *Event) []*Subscription {
+	keys := make([]scopeKey, 0, len(e.UserIds)+1)
+	if e.GroupId > 0 {
+		keys = append(keys, scopeKey{ScopeGroup, e.GroupId})
+	}
+	for _, id := range e.UserIds {
+		keys = append(keys, scopeKey{ScopeUser, id})
+	}
+	w.m.RLock()
+	defer w.m.RUnlock()
+	seen := make(map[int64]bool)
+	matched := make([]*Subscription, 0)
+	for _, key := range keys {
+		for _, sub := range w.subs[key] {
+			if !seen[sub.ID] && sub.Accept(e.Type) {
+				seen[sub.ID] = true
+				matched = append(matched, sub)
+			}
+		}
+	}
+	return matched
+}
+
+// Run 加载订阅后启动投递协程和补偿扫描
+func (w *Webhook) Run() {
+	w.refresh(context.Background())
+	utils.SafeGo(func() {
+		t := time.NewTicker(refreshInterval)
+		defer t.Stop()
+		for range t.C {
+			w.refresh(context.Background())
+		}
+	})
+	w.relay.Run()
+}
+
+// refresh 订阅数量不多，定期全量加载到内存，避免每个事件都查库
+func (w *Webhook) refresh(ctx context.Context) {
+	var list []*Subscription
+	err := w.db.Wrap(ctx, "ListWebhookSubscription", func(tx *gorm.DB) *gorm.DB {
+		return tx.Find(&list)
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return
+	}
+	subs := make(map[scopeKey][]*Subscription, len(list))
+	for _, sub := range list {
+		key := scopeKey{sub.ScopeKind, sub.ScopeId}
+		subs[key] = append(subs[key], sub)
+	}
+	w.m.Lock()
+	w.subs = subs
+	w.m.Unlock()
+}
+
+func (w *Webhook) listDue(ctx context.Context, afterId int64, limit int) ([]*Delivery, error) {
+	var list []*Delivery
+	err := w.db.Wrap(ctx, "ListWebhookDelivery", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("source=? AND status=? AND next_at<=? AND id>?", w.source, StatusPending, time.Now(), afterId).
+			Order("id ASC").Limit(limit).Find(&list)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListWebhookDelivery")
+	}
+	return list, nil
+}
+
+func (w *Webhook) deliver(ctx context.Context, d *Delivery) {
+	var sub *Subscription
+	err := w.db.Wrap(ctx, "FindWebhookSubscription", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&sub, "id=?", d.SubscriptionId)
+	})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("err: %v", err)
+		return
+	}
+	d.Attempts++
+	var statusCode int
+	start := time.Now()
+	if sub == nil {
+		// 订阅已删除，直接进入死信
+		err = errors.New("subscription deleted")
+		d.Attempts = maxAttempts
+	} else {
+		statusCode, err = Send(ctx, w.client, sub, d)
+	}
+	attempt := &Attempt{
+		DeliveryId:     d.ID,
+		SubscriptionId: d.SubscriptionId,
+		EventId:        d.EventId,
+		EventType:      d.EventType,
+		Attempt:        d.Attempts,
+		StatusCode:     statusCode,
+		DurationMs:     time.Since(start).Milliseconds(),
+		Result:         StatusDone,
+	}
+	values := map[string]any{
+		"attempts": d.Attempts,
+		"status":   StatusDone,
+	}
+	if err != nil {
+		log.Errorf("deliver webhook %d failed, attempts: %d, err: %v", d.ID, d.Attempts, err)
+		attempt.Error = outbox.Truncate(err.Error(), 255)
+		attempt.Result = StatusPending
+		values["status"] = StatusPending
+		values["next_at"] = time.Now().Add(outbox.Backoff(d.Attempts))
+		values["last_error"] = attempt.Error
+		if d.Attempts >= maxAttempts {
+			attempt.Result = StatusDead
+			values["status"] = StatusDead
+		}
+	}
+	err = w.db.InTx(ctx, func(ctx context.Context) error {
+		err := w.db.Wrap(ctx, "AddWebhookAttempt", func(tx *gorm.DB) *gorm.DB {
+			return tx.Create(attempt)
+		})
+		if err != nil {
+			return err
+		}
+		return w.db.Wrap(ctx, "UpdateWebhookDelivery", func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&Delivery{}).Where("id=? AND status=?", d.ID, StatusPending).Updates(values)
+		})
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
+// Subscribe 创建订阅并生成签名密钥
+func (w *Webhook) Subscribe(ctx context.Context, sub *Subscription) error {
+	sub.Secret = newSecret()
+	err := w.db.Wrap(ctx, "CreateWebhookSubscription", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(sub)
+	})
+	if err != nil {
+		return errors.Wrap(err, "CreateWebhookSubscription")
+	}
+	w.refresh(ctx)
+	return nil
+}
+
+func (w *Webhook) FindSubscription(ctx context.Context, id int64) (*Subscription, error) {
+	var sub *Subscription
+	err := w.db.Wrap(ctx, "FindWebhookSubscription", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&sub, "id=?", id)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindWebhookSubscription")
+	}
+	return sub, nil
+}
+
+func (w *Webhook) ListSubscriptions(ctx context.Context, ownerId int64) ([]*Subscription, error) {
+	var list []*Subscription
+	err := w.db.Wrap(ctx, "ListWebhookSubscription", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("owner_id=?", ownerId).Order("id DESC").Find(&list)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListWebhookSubscription")
+	}
+	return list, nil
+}
+
+// Unsubscribe 删除订阅，未完成的投递会进入死信
+func (w *Webhook) Unsubscribe(ctx context.Context, id int64) error {
+	err := w.db.Wrap(ctx, "DeleteWebhookSubscription", func(tx *gorm.DB) *gorm.DB {
+		return tx.Delete(&Subscription{}, "id=?", id)
+	})
+	if err != nil {
+		return errors.Wrap(err, "DeleteWebhookSubscription")
+	}
+	w.refresh(ctx)
+	return nil
+}
+
+// ListAttempts 按时间倒序返回订阅最近的投递记录
+func (w *Webhook) ListAttempts(ctx context.Context, subscriptionId int64, limit int) ([]*Attempt, error) {
+	var list []*Attempt
+	err := w.db.Wrap(ctx, "ListWebhookAttempt", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("subscription_id=?", subscriptionId).Order("id DESC").Limit(limit).Find(&list)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListWebhookAttempt")
+	}
+	return list, nil
+}
+
+func newEventId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func newSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
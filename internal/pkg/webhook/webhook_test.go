@@ -0,0 +1,153 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/access"
+	"go-im/internal/common/protocol"
+	"go-im/internal/pkg/mjson"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestSend(t *testing.T) {
+	sub := &Subscription{Url: "", Secret: "secret"}
+	d := &Delivery{EventId: "abc", EventType: EventMessageSent, Payload: []byte(`{"id":"abc"}`)}
+	var verified bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ts, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		verified = Verify(sub.Secret, ts, body, r.Header.Get(HeaderSignature)) &&
+			r.Header.Get(HeaderEvent) == EventMessageSent && r.Header.Get(HeaderEventId) == "abc"
+	}))
+	defer srv.Close()
+	sub.Url = srv.URL
+	code, err := Send(context.Background(), srv.Client(), sub, d)
+	if err != nil || code != http.StatusOK {
+		t.Fatalf("unexpected result %d, %v", code, err)
+	}
+	if !verified {
+		t.Fatal("signature or headers not verified")
+	}
+}
+
+func TestSendFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	sub := &Subscription{Url: srv.URL, Secret: "secret"}
+	code, err := Send(context.Background(), srv.Client(), sub, &Delivery{Payload: []byte(`{}`)})
+	if err == nil || code != http.StatusInternalServerError {
+		t.Fatalf("expected failure, got %d, %v", code, err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	sig := Sign("secret", 100, body)
+	if !Verify("secret", 100, body, sig) {
+		t.Fatal("expected valid signature")
+	}
+	if Verify("secret", 101, body, sig) || Verify("other", 100, body, sig) {
+		t.Fatal("expected invalid signature")
+	}
+}
+
+func TestAccept(t *testing.T) {
+	sub := &Subscription{}
+	if !sub.Accept(EventMessageSent) {
+		t.Fatal("empty events should accept all")
+	}
+	sub.Events = EventMemberJoined + "," + EventGroupUpdated
+	if !sub.Accept(EventGroupUpdated) || sub.Accept(EventMessageSent) {
+		t.Fatal("unexpected accept result")
+	}
+}
+
+func TestFromPush(t *testing.T) {
+	msg, _ := mjson.Marshal(&access.MessageBody{Kind: "group", FromId: 1, ToId: 10, HiddenFor: []int64{2}})
+	e := FromPush(protocol.PushBody{Type: protocol.MessageTopic, Body: msg})
+	if e == nil || e.Type != EventMessageSent || e.GroupId != 10 || !slices.Equal(e.UserIds, []int64{1}) {
+		t.Fatalf("unexpected event %+v", e)
+	}
+	var sent access.MessageBody
+	if err := mjson.Unmarshal(e.Data, &sent); err != nil || len(sent.HiddenFor) > 0 {
+		t.Fatalf("hidden list should not be sent, got %v, %v", sent.HiddenFor, err)
+	}
+
+	joined, _ := mjson.Marshal(&access.GroupUpdatedInfoMsg{GroupId: 10, Joined: []int64{2, 3}})
+	e = FromPush(protocol.PushBody{
+		Type: protocol.GroupEventTopic,
+		Key:  fmt.Appendf([]byte{}, "%d", protocol.GroupMemberChangeMsg),
+		Body: joined,
+	})
+	if e == nil || e.Type != EventMemberJoined || e.GroupId != 10 || !slices.Equal(e.UserIds, []int64{2, 3}) {
+		t.Fatalf("unexpected event %+v", e)
+	}
+
+	read, _ := mjson.Marshal(map[string]any{})
+	if e := FromPush(protocol.PushBody{Type: protocol.MessageEventTopic, Key: []byte("0"), Body: read}); e != nil {
+		t.Fatalf("expected nil, got %+v", e)
+	}
+}
+
+func TestCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(HeaderEvent) != EventCardAction {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"card":{"title":"done"}}`))
+	}))
+	defer srv.Close()
+	w := &Webhook{
+		client: srv.Client(),
+		subs: map[scopeKey][]*Subscription{
+			{ScopeUser, 1}: {{ID: 1, Url: srv.URL, Secret: "secret", Events: EventCardAction}},
+		},
+	}
+	b, err := w.Call(context.Background(), &Event{Type: EventCardAction, UserIds: []int64{1}, Data: []byte(`{}`)})
+	if err != nil || string(b) != `{"card":{"title":"done"}}` {
+		t.Fatalf("unexpected result %s, %v", b, err)
+	}
+	_, err = w.Call(context.Background(), &Event{Type: EventCardAction, UserIds: []int64{2}, Data: []byte(`{}`)})
+	if err != ErrNoSubscriber {
+		t.Fatalf("expected ErrNoSubscriber, got %v", err)
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	for _, u := range []string{
+		"ftp://1.1.1.1/",
+		"http://127.0.0.1:8080/hook",
+		"http://10.0.0.1/",
+		"http://192.168.1.1/",
+		"http://169.254.169.254/latest/meta-data",
+		"http://100.64.0.1/",
+		"http://[::1]/",
+		"http://0.0.0.0/",
+	} {
+		if err := CheckURL(context.Background(), u); err == nil {
+			t.Fatalf("expected %s to be denied", u)
+		}
+	}
+	if err := CheckURL(context.Background(), "https://1.1.1.1/hook"); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
+
+func TestClientDenyLoopback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	sub := &Subscription{Url: srv.URL, Secret: "secret"}
+	_, err := Send(context.Background(), newClient(), sub, &Delivery{Payload: []byte(`{}`)})
+	if !errors.Is(err, ErrAddressDenied) {
+		t.Fatalf("expected address denied, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"go-im/internal/pkg/db"
 	"go-im/internal/pkg/kafka"
 	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/moderation"
 	"go-im/internal/pkg/mprometheus"
 	"go-im/internal/pkg/mtrace"
 	"go-im/internal/pkg/redis"
@@ -25,6 +26,10 @@ type Config struct {
 	Trace        mtrace.Config      `yaml:"trace"`
 	Prometheus   mprometheus.Config `yaml:"prometheus"`
 	AccessClient rpc.ClientConfig   `yaml:"access_client"`
+	// 每个机器人每分钟允许的 API 请求数
+	BotRateLimit int `yaml:"bot_rate_limit"`
+
+	Moderation moderation.Config `yaml:"moderation"`
 }
 
 func ParseConfig(file string) *Config {
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 机器人的 API token，只保存哈希
+type BotToken struct {
+	ID        int64  `gorm:"id" json:"id"`
+	BotId     int64  `gorm:"bot_id" json:"bot_id"`
+	Name      string `gorm:"name" json:"name"`
+	TokenHash string `gorm:"token_hash" json:"-"`
+	// 最近一次使用时间，按分钟更新
+	LastUsedAt *time.Time `gorm:"last_used_at" json:"last_used_at"`
+	RevokedAt  *time.Time `gorm:"revoked_at" json:"revoked_at"`
+	gorm.Model
+}
+
+func (b BotToken) TableName() string {
+	return "bot_token"
+}
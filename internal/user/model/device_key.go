@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DeviceKey 设备的端到端加密公钥，服务端只保存公钥
+type DeviceKey struct {
+	ID       int64  `gorm:"id" json:"id"`
+	UserId   int64  `gorm:"user_id" json:"user_id"`
+	DeviceId string `gorm:"device_id" json:"device_id"`
+	// 以下均为 base64 编码
+	IdentityKey     string `gorm:"identity_key" json:"identity_key"`
+	SignedPrekeyId  int64  `gorm:"signed_prekey_id" json:"signed_prekey_id"`
+	SignedPrekey    string `gorm:"signed_prekey" json:"signed_prekey"`
+	SignedPrekeySig string `gorm:"signed_prekey_sig" json:"signed_prekey_sig"`
+	// 吊销后不再返回密钥，重新上传身份密钥后恢复
+	RevokedAt *time.Time `gorm:"revoked_at" json:"revoked_at"`
+	gorm.Model
+}
+
+func (d DeviceKey) TableName() string {
+	return "device_key"
+}
+
+// OneTimePrekey 一次性预密钥，随密钥包发出后即删除
+type OneTimePrekey struct {
+	ID        int64  `gorm:"id" json:"id"`
+	UserId    int64  `gorm:"user_id" json:"user_id"`
+	DeviceId  string `gorm:"device_id" json:"device_id"`
+	KeyId     int64  `gorm:"key_id" json:"key_id"`
+	PublicKey string `gorm:"public_key" json:"public_key"`
+	gorm.Model
+}
+
+func (o OneTimePrekey) TableName() string {
+	return "one_time_prekey"
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// PushToken 设备推送令牌，同一令牌只属于最后登录的用户
+type PushToken struct {
+	ID       int64  `gorm:"id" json:"id"`
+	UserId   int64  `gorm:"user_id" json:"user_id"`
+	Platform string `gorm:"platform" json:"platform"`
+	Token    string `gorm:"token" json:"token"`
+	gorm.Model
+}
+
+func (p PushToken) TableName() string {
+	return "push_token"
+}
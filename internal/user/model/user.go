@@ -11,6 +11,17 @@ type Users struct {
 	Gender   string `gorm:"gender" json:"gender"`
 	Avatar   string `gorm:"avatar" json:"avatar"`
 	Password string `gorm:"password" json:"password"`
+	IsBot    bool   `gorm:"is_bot" json:"is_bot"`
+	// 机器人的创建者，普通用户为 0
+	OwnerId int64 `gorm:"owner_id" json:"owner_id"`
+	// 单聊消息接收策略，为空时使用默认策略
+	MessagePolicy string `gorm:"message_policy" json:"message_policy"`
+	// 推送内容预览：full / sender / hidden，为空时为 full
+	PushPreview string `gorm:"push_preview" json:"push_preview"`
+	// 免打扰时段，如 22:00-07:00，为空表示不设置
+	QuietHours string `gorm:"quiet_hours" json:"quiet_hours"`
+	// 用户时区相对 UTC 的分钟数
+	TzOffset int `gorm:"tz_offset" json:"tz_offset"`
 	gorm.Model
 }
 
@@ -0,0 +1,17 @@
+package model
+
+import "gorm.io/gorm"
+
+// UserBlock 用户拉黑记录，取消拉黑时直接删除
+type UserBlock struct {
+	ID       int64 `gorm:"id" json:"id"`
+	UserId   int64 `gorm:"user_id" json:"user_id"`
+	TargetId int64 `gorm:"target_id" json:"target_id"`
+	// 是否同时隐藏对方在群里发的消息
+	HideGroup bool `gorm:"hide_group" json:"hide_group"`
+	gorm.Model
+}
+
+func (u UserBlock) TableName() string {
+	return "user_block"
+}
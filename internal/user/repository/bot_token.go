@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/pkg/db"
+	"go-im/internal/user/model"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type BotTokenRepository struct {
+	db *db.DB
+}
+
+func NewBotTokenRepository(db *db.DB) *BotTokenRepository {
+	return &BotTokenRepository{db}
+}
+
+func (b *BotTokenRepository) Insert(ctx context.Context, data *model.BotToken) (int64, error) {
+	err := b.db.Wrap(ctx, "Insert", func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(data)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Insert")
+	}
+	return data.ID, nil
+}
+
+// FindByHash 只返回未吊销的 token
+func (b *BotTokenRepository) FindByHash(ctx context.Context, hash string) (*model.BotToken, error) {
+	var resp *model.BotToken
+	err := b.db.Wrap(ctx, "FindByHash", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "token_hash=? AND revoked_at IS NULL", hash)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByHash")
+	}
+	return resp, nil
+}
+
+func (b *BotTokenRepository) FindOne(ctx context.Context, id int64) (*model.BotToken, error) {
+	var resp *model.BotToken
+	err := b.db.Wrap(ctx, "FindOne", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "id=?", id)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindOne")
+	}
+	return resp, nil
+}
+
+func (b *BotTokenRepository) ListByBot(ctx context.Context, botId int64) ([]*model.BotToken, error) {
+	var resp []*model.BotToken
+	err := b.db.Wrap(ctx, "ListByBot", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("bot_id=?", botId).Order("id DESC").Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListByBot")
+	}
+	return resp, nil
+}
+
+func (b *BotTokenRepository) Revoke(ctx context.Context, id int64) error {
+	err := b.db.Wrap(ctx, "Revoke", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.BotToken{}).Where("id=? AND revoked_at IS NULL", id).Update("revoked_at", time.Now())
+	})
+	if err != nil {
+		return errors.Wrap(err, "Revoke")
+	}
+	return nil
+}
+
+func (b *BotTokenRepository) Touch(ctx context.Context, id int64) error {
+	err := b.db.Wrap(ctx, "Touch", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.BotToken{}).Where("id=?", id).Update("last_used_at", time.Now())
+	})
+	if err != nil {
+		return errors.Wrap(err, "Touch")
+	}
+	return nil
+}
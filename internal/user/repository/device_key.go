@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/pkg/db"
+	"go-im/internal/user/model"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 并发领取同一把预密钥时的重试次数
+const claimPrekeyRetry = 3
+
+type DeviceKeyRepository struct {
+	db *db.DB
+}
+
+func NewDeviceKeyRepository(db *db.DB) *DeviceKeyRepository {
+	return &DeviceKeyRepository{db}
+}
+
+func (d *DeviceKeyRepository) FindOne(ctx context.Context, userId int64, deviceId string) (*model.DeviceKey, error) {
+	var resp *model.DeviceKey
+	err := d.db.Wrap(ctx, "FindOne", func(tx *gorm.DB) *gorm.DB {
+		return tx.First(&resp, "user_id=? AND device_id=?", userId, deviceId)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindOne")
+	}
+	return resp, nil
+}
+
+// Upsert 上传或轮换设备密钥，同时解除吊销
+func (d *DeviceKeyRepository) Upsert(ctx context.Context, data *model.DeviceKey) error {
+	err := d.db.Wrap(ctx, "Upsert", func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "device_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"identity_key", "signed_prekey_id", "signed_prekey", "signed_prekey_sig", "revoked_at", "updated_at",
+			}),
+		}).Create(data)
+	})
+	if err != nil {
+		return errors.Wrap(err, "Upsert")
+	}
+	return nil
+}
+
+// ListActive 未吊销的设备
+func (d *DeviceKeyRepository) ListActive(ctx context.Context, userIds []int64) ([]*model.DeviceKey, error) {
+	var resp []*model.DeviceKey
+	err := d.db.Wrap(ctx, "ListActive", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("user_id IN ? AND revoked_at IS NULL", userIds).Order("id ASC").Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListActive")
+	}
+	return resp, nil
+}
+
+// Revoke 吊销设备并删除剩余的一次性预密钥，返回设备是否存在
+func (d *DeviceKeyRepository) Revoke(ctx context.Context, userId int64, deviceId string) (bool, error) {
+	var affected int64
+	err := d.db.Wrap(ctx, "Revoke", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.DeviceKey{}).Where("user_id=? AND device_id=? AND revoked_at IS NULL", userId, deviceId).
+			Update("revoked_at", time.Now())
+		affected = tx.RowsAffected
+		return tx
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "Revoke")
+	}
+	if affected == 0 {
+		return false, nil
+	}
+	return true, d.DeletePrekeys(ctx, userId, deviceId)
+}
+
+// AddPrekeys 重复的 key_id 忽略
+func (d *DeviceKeyRepository) AddPrekeys(ctx context.Context, list []*model.OneTimePrekey) error {
+	if len(list) == 0 {
+		return nil
+	}
+	err := d.db.Wrap(ctx, "AddPrekeys", func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(list)
+	})
+	if err != nil {
+		return errors.Wrap(err, "AddPrekeys")
+	}
+	return nil
+}
+
+func (d *DeviceKeyRepository) DeletePrekeys(ctx context.Context, userId int64, deviceId string) error {
+	err := d.db.Wrap(ctx, "DeletePrekeys", func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Where("user_id=? AND device_id=?", userId, deviceId).Delete(&model.OneTimePrekey{})
+	})
+	if err != nil {
+		return errors.Wrap(err, "DeletePrekeys")
+	}
+	return nil
+}
+
+// CountPrekeys 每个设备剩余的一次性预密钥数
+func (d *DeviceKeyRepository) CountPrekeys(ctx context.Context, userId int64) (map[string]int64, error) {
+	var rows []struct {
+		DeviceId string
+		Count    int64
+	}
+	err := d.db.Wrap(ctx, "CountPrekeys", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.OneTimePrekey{}).Select("device_id, COUNT(*) AS count").
+			Where("user_id=?", userId).Group("device_id").Scan(&rows)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "CountPrekeys")
+	}
+	resp := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		resp[row.DeviceId] = row.Count
+	}
+	return resp, nil
+}
+
+// ClaimPrekey 取出并删除一把一次性预密钥，用完时返回 nil
+func (d *DeviceKeyRepository) ClaimPrekey(ctx context.Context, userId int64, deviceId string) (*model.OneTimePrekey, error) {
+	for range claimPrekeyRetry {
+		var list []*model.OneTimePrekey
+		err := d.db.Wrap(ctx, "ClaimPrekey", func(tx *gorm.DB) *gorm.DB {
+			return tx.Where("user_id=? AND device_id=?", userId, deviceId).Order("id ASC").Limit(1).Find(&list)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "ClaimPrekey")
+		}
+		if len(list) == 0 {
+			return nil, nil
+		}
+		var affected int64
+		err = d.db.Wrap(ctx, "ClaimPrekey", func(tx *gorm.DB) *gorm.DB {
+			tx = tx.Unscoped().Where("id=?", list[0].ID).Delete(&model.OneTimePrekey{})
+			affected = tx.RowsAffected
+			return tx
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "ClaimPrekey")
+		}
+		// 被其他请求抢先领取时换一把
+		if affected > 0 {
+			return list[0], nil
+		}
+	}
+	return nil, nil
+}
@@ -69,7 +69,7 @@ func (f *FriendApplyRepository) GetFriendApply(ctx context.Context, id int64, us
 }
 
 func (f *FriendApplyRepository) AgreeAndAddFriend(ctx context.Context, applyId int64, userId int64, friendId int64) error {
-	err := f.db.Transaction(func(tx *gorm.DB) error {
+	err := f.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
 		_, span := mtrace.StartSpan(ctx, "AgreeAndAddFriend", trace.WithSpanKind(trace.SpanKindInternal))
 		defer mtrace.EndSpan(span)
 		sql := make([]string, 0)
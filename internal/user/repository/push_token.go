@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/pkg/db"
+	"go-im/internal/user/model"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PushTokenRepository struct {
+	db *db.DB
+}
+
+func NewPushTokenRepository(db *db.DB) *PushTokenRepository {
+	return &PushTokenRepository{db}
+}
+
+// Upsert 令牌已存在时转到当前用户名下，避免换号登录后推送给上一个用户
+func (p *PushTokenRepository) Upsert(ctx context.Context, data *model.PushToken) error {
+	err := p.db.Wrap(ctx, "Upsert", func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "token"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_id", "platform", "updated_at"}),
+		}).Create(data)
+	})
+	if err != nil {
+		return errors.Wrap(err, "Upsert")
+	}
+	return nil
+}
+
+func (p *PushTokenRepository) Delete(ctx context.Context, userId int64, token string) (bool, error) {
+	var affected int64
+	err := p.db.Wrap(ctx, "Delete", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Unscoped().Where("user_id=? AND token=?", userId, token).Delete(&model.PushToken{})
+		affected = tx.RowsAffected
+		return tx
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "Delete")
+	}
+	return affected > 0, nil
+}
+
+// DeleteTokens 删除推送通道返回失效的令牌
+func (p *PushTokenRepository) DeleteTokens(ctx context.Context, tokens []string) error {
+	err := p.db.Wrap(ctx, "DeleteTokens", func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Where("token IN ?", tokens).Delete(&model.PushToken{})
+	})
+	if err != nil {
+		return errors.Wrap(err, "DeleteTokens")
+	}
+	return nil
+}
+
+func (p *PushTokenRepository) ListByUsers(ctx context.Context, userIds []int64) ([]*model.PushToken, error) {
+	var resp []*model.PushToken
+	err := p.db.Wrap(ctx, "ListByUsers", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("user_id IN ?", userIds).Order("id ASC").Find(&resp)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListByUsers")
+	}
+	return resp, nil
+}
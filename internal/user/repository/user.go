@@ -4,6 +4,7 @@ import (
 	"context"
 	"go-im/internal/pkg/db"
 	"go-im/internal/user/model"
+	"time"
 
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -38,6 +39,71 @@ func (u *UserRepository) FindOne(ctx context.Context, id int64) (*model.Users, e
 	return user, nil
 }
 
+func (u *UserRepository) FindByIds(ctx context.Context, ids []int64) ([]*model.Users, error) {
+	var list []*model.Users
+	err := u.db.Wrap(ctx, "FindByIds", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id IN ?", ids).Find(&list)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByIds")
+	}
+	return list, nil
+}
+
+// ListIds 按ID升序返回 afterId 之后的用户ID，registeredAfter 为空时不过滤注册时间
+func (u *UserRepository) ListIds(ctx context.Context, afterId int64, registeredAfter *time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	err := u.db.Wrap(ctx, "ListIds", func(tx *gorm.DB) *gorm.DB {
+		// 机器人不接收公告
+		tx = tx.Model(&model.Users{}).Where("id>? AND is_bot=?", afterId, false)
+		if registeredAfter != nil {
+			tx = tx.Where("created_at>=?", *registeredAfter)
+		}
+		return tx.Order("id ASC").Limit(limit).Pluck("id", &ids)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListIds")
+	}
+	return ids, nil
+}
+
+func (u *UserRepository) ListBots(ctx context.Context, ownerId int64) ([]*model.Users, error) {
+	var list []*model.Users
+	err := u.db.Wrap(ctx, "ListBots", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("owner_id=? AND is_bot=?", ownerId, true).Order("id DESC").Find(&list)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListBots")
+	}
+	return list, nil
+}
+
+// UpdateMessagePolicy 单独更新，允许设置为空值
+func (u *UserRepository) UpdateMessagePolicy(ctx context.Context, id int64, policy string) error {
+	err := u.db.Wrap(ctx, "UpdateMessagePolicy", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.Users{}).Where("id=?", id).Update("message_policy", policy)
+	})
+	if err != nil {
+		return errors.Wrap(err, "UpdateMessagePolicy")
+	}
+	return nil
+}
+
+// UpdatePushSetting 单独更新，允许设置为空值
+func (u *UserRepository) UpdatePushSetting(ctx context.Context, id int64, preview, quietHours string, tzOffset int) error {
+	err := u.db.Wrap(ctx, "UpdatePushSetting", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.Users{}).Where("id=?", id).Updates(map[string]any{
+			"push_preview": preview,
+			"quiet_hours":  quietHours,
+			"tz_offset":    tzOffset,
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "UpdatePushSetting")
+	}
+	return nil
+}
+
 func (u *UserRepository) FindOneByPhone(ctx context.Context, phone string) (*model.Users, error) {
 	var user *model.Users
 	err := u.db.Wrap(ctx, "FindOneByPhone", func(tx *gorm.DB) *gorm.DB {
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"go-im/internal/pkg/db"
+	"go-im/internal/user/model"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserBlockRepository struct {
+	db *db.DB
+}
+
+func NewUserBlockRepository(db *db.DB) *UserBlockRepository {
+	return &UserBlockRepository{db}
+}
+
+// Upsert 重复拉黑时只更新是否隐藏群消息
+func (u *UserBlockRepository) Upsert(ctx context.Context, data *model.UserBlock) error {
+	err := u.db.Wrap(ctx, "Upsert", func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"hide_group", "updated_at"}),
+		}).Create(data)
+	})
+	if err != nil {
+		return errors.Wrap(err, "Upsert")
+	}
+	return nil
+}
+
+func (u *UserBlockRepository) Delete(ctx context.Context, userId, targetId int64) error {
+	err := u.db.Wrap(ctx, "Delete", func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Where("user_id=? AND target_id=?", userId, targetId).Delete(&model.UserBlock{})
+	})
+	if err != nil {
+		return errors.Wrap(err, "Delete")
+	}
+	return nil
+}
+
+func (u *UserBlockRepository) ListByUser(ctx context.Context, userId int64) ([]*model.UserBlock, error) {
+	var list []*model.UserBlock
+	err := u.db.Wrap(ctx, "ListByUser", func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("user_id=?", userId).Order("id DESC").Find(&list)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListByUser")
+	}
+	return list, nil
+}
+
+// ListHiders 拉黑了 targetId 并隐藏其群消息的用户
+func (u *UserBlockRepository) ListHiders(ctx context.Context, targetId int64) ([]int64, error) {
+	var ids []int64
+	err := u.db.Wrap(ctx, "ListHiders", func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&model.UserBlock{}).Where("target_id=? AND hide_group=?", targetId, true).Pluck("user_id", &ids)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListHiders")
+	}
+	return ids, nil
+}
@@ -0,0 +1,222 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/types"
+	"go-im/internal/pkg/log"
+	"go-im/internal/user/model"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	blockCacheTTL = time.Hour
+	// 缓存中表示已加载的占位字段，列表为空时也能命中缓存
+	blockCacheLoaded = "0"
+)
+
+func (s *Server) BlockUser(ctx context.Context, in *user.BlockUserReq) (*user.BlockUserResp, error) {
+	if in.TargetId == in.UserId {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	if _, err := s.userRepository.FindOne(ctx, in.TargetId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrUserNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	err := s.userBlockRepository.Upsert(ctx, &model.UserBlock{
+		UserId:    in.UserId,
+		TargetId:  in.TargetId,
+		HideGroup: in.HideGroup,
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	s.clearBlockCache(ctx, in.UserId, in.TargetId)
+	return &user.BlockUserResp{}, nil
+}
+
+func (s *Server) UnblockUser(ctx context.Context, in *user.UnblockUserReq) (*user.UnblockUserResp, error) {
+	if err := s.userBlockRepository.Delete(ctx, in.UserId, in.TargetId); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	s.clearBlockCache(ctx, in.UserId, in.TargetId)
+	return &user.UnblockUserResp{}, nil
+}
+
+func (s *Server) ListBlocked(ctx context.Context, in *user.ListBlockedReq) (*user.ListBlockedResp, error) {
+	list, err := s.userBlockRepository.ListByUser(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &user.ListBlockedResp{
+		List: make([]*user.BlockedUser, 0, len(list)),
+	}
+	if len(list) == 0 {
+		return resp, nil
+	}
+	ids := make([]int64, 0, len(list))
+	for _, item := range list {
+		ids = append(ids, item.TargetId)
+	}
+	users, err := s.userRepository.FindByIds(ctx, ids)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	userMap := make(map[int64]*model.Users, len(users))
+	for _, usr := range users {
+		userMap[usr.ID] = usr
+	}
+	for _, item := range list {
+		info := &user.BlockedUser{
+			UserId:     item.TargetId,
+			HideGroup:  item.HideGroup,
+			CreateTime: item.CreatedAt.UnixMilli(),
+		}
+		if usr, ok := userMap[item.TargetId]; ok {
+			info.Username, info.Avatar = usr.Username, usr.Avatar
+		}
+		resp.List = append(resp.List, info)
+	}
+	return resp, nil
+}
+
+func (s *Server) CheckBlocked(ctx context.Context, in *user.CheckBlockedReq) (*user.CheckBlockedResp, error) {
+	blocks, err := s.loadBlocks(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &user.CheckBlockedResp{}
+	for _, id := range in.TargetIds {
+		hide, ok := blocks[id]
+		if !ok {
+			continue
+		}
+		resp.BlockedIds = append(resp.BlockedIds, id)
+		if hide {
+			resp.HiddenIds = append(resp.HiddenIds, id)
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) ListHiders(ctx context.Context, in *user.ListHidersReq) (*user.ListHidersResp, error) {
+	key := fmt.Sprintf(types.CacheHiderKey, in.UserId)
+	ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.SMembers(ctx, key)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	} else if members := ret.([]string); len(members) > 0 {
+		resp := &user.ListHidersResp{}
+		for _, m := range members {
+			if id, _ := strconv.ParseInt(m, 10, 64); id > 0 {
+				resp.UserIds = append(resp.UserIds, id)
+			}
+		}
+		return resp, nil
+	}
+	ids, err := s.userBlockRepository.ListHiders(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	members := make([]any, 0, len(ids)+1)
+	members = append(members, blockCacheLoaded)
+	for _, id := range ids {
+		members = append(members, id)
+	}
+	pipe := s.redis.Pipeline()
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, blockCacheTTL)
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline set hiders", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	return &user.ListHidersResp{
+		UserIds: ids,
+	}, nil
+}
+
+// loadBlocks 返回用户拉黑的人及是否隐藏其群消息，缓存未命中时从库中加载
+func (s *Server) loadBlocks(ctx context.Context, userId int64) (map[int64]bool, error) {
+	key := fmt.Sprintf(types.CacheBlockKey, userId)
+	ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.HGetAll(ctx, key)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	} else if fields := ret.(map[string]string); len(fields) > 0 {
+		blocks := make(map[int64]bool, len(fields))
+		for k, v := range fields {
+			if id, _ := strconv.ParseInt(k, 10, 64); id > 0 {
+				blocks[id] = v == "1"
+			}
+		}
+		return blocks, nil
+	}
+	list, err := s.userBlockRepository.ListByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make(map[int64]bool, len(list))
+	values := make([]any, 0, 2*len(list)+2)
+	values = append(values, blockCacheLoaded, "")
+	for _, item := range list {
+		blocks[item.TargetId] = item.HideGroup
+		hide := "0"
+		if item.HideGroup {
+			hide = "1"
+		}
+		values = append(values, item.TargetId, hide)
+	}
+	pipe := s.redis.Pipeline()
+	pipe.HSet(ctx, key, values...)
+	pipe.Expire(ctx, key, blockCacheTTL)
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline set blocks", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	return blocks, nil
+}
+
+// isBlocked userId 是否拉黑了 targetId
+func (s *Server) isBlocked(ctx context.Context, userId, targetId int64) (bool, error) {
+	blocks, err := s.loadBlocks(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	_, ok := blocks[targetId]
+	return ok, nil
+}
+
+func (s *Server) clearBlockCache(ctx context.Context, userId, targetId int64) {
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Del(ctx, fmt.Sprintf(types.CacheBlockKey, userId), fmt.Sprintf(types.CacheHiderKey, targetId))
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
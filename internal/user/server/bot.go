@@ -0,0 +1,303 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/types"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/moderation"
+	"go-im/internal/user/model"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultBotRateLimit = 60
+	maxBotsPerOwner     = 20
+	maxTokensPerBot     = 10
+	maxBotTokenName     = 50
+
+	botTokenPrefix   = "bot_"
+	botTokenCacheTTL = 5 * time.Minute
+)
+
+func (s *Server) CreateBot(ctx context.Context, in *user.CreateBotReq) (*user.CreateBotResp, error) {
+	if in.Username == "" {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	username, err := s.moderator.Check(ctx, moderation.SceneUsername, in.OwnerId, in.Username)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	bots, err := s.userRepository.ListBots(ctx, in.OwnerId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if len(bots) >= maxBotsPerOwner {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	phone, err := botPhone()
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	bot := &model.Users{
+		Username: username,
+		Phone:    phone,
+		Avatar:   in.Avatar,
+		IsBot:    true,
+		OwnerId:  in.OwnerId,
+	}
+	var token string
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if _, err := s.userRepository.Insert(ctx, bot); err != nil {
+			return err
+		}
+		var err error
+		token, _, err = s.issueBotToken(ctx, bot.ID, "default")
+		return err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.CreateBotResp{
+		BotId: bot.ID,
+		Token: token,
+	}, nil
+}
+
+func (s *Server) ListBot(ctx context.Context, in *user.ListBotReq) (*user.ListBotResp, error) {
+	bots, err := s.userRepository.ListBots(ctx, in.OwnerId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &user.ListBotResp{
+		List: make([]*user.BotInfo, 0, len(bots)),
+	}
+	for _, bot := range bots {
+		resp.List = append(resp.List, &user.BotInfo{
+			BotId:      bot.ID,
+			Username:   bot.Username,
+			Avatar:     bot.Avatar,
+			CreateTime: bot.CreatedAt.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) CreateBotToken(ctx context.Context, in *user.CreateBotTokenReq) (*user.CreateBotTokenResp, error) {
+	if len([]rune(in.Name)) > maxBotTokenName {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	if err := s.ownBot(ctx, in.OwnerId, in.BotId); err != nil {
+		return nil, err
+	}
+	tokens, err := s.botTokenRepository.ListByBot(ctx, in.BotId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	active := 0
+	for _, t := range tokens {
+		if t.RevokedAt == nil {
+			active++
+		}
+	}
+	if active >= maxTokensPerBot {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	token, id, err := s.issueBotToken(ctx, in.BotId, in.Name)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.CreateBotTokenResp{
+		Id:    id,
+		Token: token,
+	}, nil
+}
+
+func (s *Server) ListBotToken(ctx context.Context, in *user.ListBotTokenReq) (*user.ListBotTokenResp, error) {
+	if err := s.ownBot(ctx, in.OwnerId, in.BotId); err != nil {
+		return nil, err
+	}
+	tokens, err := s.botTokenRepository.ListByBot(ctx, in.BotId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &user.ListBotTokenResp{
+		List: make([]*user.BotTokenInfo, 0, len(tokens)),
+	}
+	for _, t := range tokens {
+		info := &user.BotTokenInfo{
+			Id:         t.ID,
+			Name:       t.Name,
+			Revoked:    t.RevokedAt != nil,
+			CreateTime: t.CreatedAt.UnixMilli(),
+		}
+		if t.LastUsedAt != nil {
+			info.LastUsedAt = t.LastUsedAt.UnixMilli()
+		}
+		resp.List = append(resp.List, info)
+	}
+	return resp, nil
+}
+
+func (s *Server) RevokeBotToken(ctx context.Context, in *user.RevokeBotTokenReq) (*user.RevokeBotTokenResp, error) {
+	if err := s.ownBot(ctx, in.OwnerId, in.BotId); err != nil {
+		return nil, err
+	}
+	t, err := s.botTokenRepository.FindOne(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if t.BotId != in.BotId {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	if err := s.botTokenRepository.Revoke(ctx, in.Id); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	key := fmt.Sprintf(types.CacheBotTokenKey, t.TokenHash)
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Del(ctx, key)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	return &user.RevokeBotTokenResp{}, nil
+}
+
+// BotAuth 先查缓存再查库，通过后按分钟窗口计数限流
+func (s *Server) BotAuth(ctx context.Context, in *user.BotAuthReq) (*user.BotAuthResp, error) {
+	if !strings.HasPrefix(in.Token, botTokenPrefix) {
+		return nil, errcode.ToRpcError(errcode.ErrTokenExpired)
+	}
+	hash := hashBotToken(in.Token)
+	key := fmt.Sprintf(types.CacheBotTokenKey, hash)
+	var botId int64
+	ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Get(ctx, key)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err == nil {
+		botId, _ = strconv.ParseInt(ret.(string), 10, 64)
+	} else if !errors.Is(err, goredis.Nil) {
+		log.Errorf("err: %v", err)
+	}
+	if botId == 0 {
+		t, err := s.botTokenRepository.FindByHash(ctx, hash)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errcode.ToRpcError(errcode.ErrTokenExpired)
+			}
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		botId = t.BotId
+		// 缓存未命中时顺便记录使用时间，避免每个请求都写库
+		if err := s.botTokenRepository.Touch(ctx, t.ID); err != nil {
+			log.Errorf("err: %v", err)
+		}
+		_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+			cmd := s.redis.Set(ctx, key, botId, botTokenCacheTTL)
+			return cmd.Val(), cmd.String(), cmd.Err()
+		})
+		if err != nil {
+			log.Errorf("err: %v", err)
+		}
+	}
+	allowed, err := s.allowBot(ctx, botId)
+	if err != nil {
+		// 限流依赖 redis，不可用时放行
+		log.Errorf("err: %v", err)
+	} else if !allowed {
+		return nil, errcode.ToRpcError(errcode.ErrTooManyRequests)
+	}
+	return &user.BotAuthResp{
+		BotId: botId,
+	}, nil
+}
+
+func (s *Server) allowBot(ctx context.Context, botId int64) (bool, error) {
+	key := fmt.Sprintf(types.CacheBotRateKey, botId, time.Now().Unix()/60)
+	pipe := s.redis.Pipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, 2*time.Minute)
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline incr bot rate", err
+	})
+	if err != nil {
+		return false, err
+	}
+	return incr.Val() <= int64(s.botRateLimit), nil
+}
+
+func (s *Server) ownBot(ctx context.Context, ownerId int64, botId int64) error {
+	bot, err := s.userRepository.FindOne(ctx, botId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errcode.ToRpcError(errcode.ErrBotNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return errcode.ToRpcError(err)
+	}
+	if !bot.IsBot || bot.OwnerId != ownerId {
+		return errcode.ToRpcError(errcode.ErrBotNotExists)
+	}
+	return nil
+}
+
+// issueBotToken 生成 token 并只保存哈希，明文只返回这一次
+func (s *Server) issueBotToken(ctx context.Context, botId int64, name string) (string, int64, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", 0, err
+	}
+	token := botTokenPrefix + hex.EncodeToString(b)
+	id, err := s.botTokenRepository.Insert(ctx, &model.BotToken{
+		BotId:     botId,
+		Name:      name,
+		TokenHash: hashBotToken(token),
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return token, id, nil
+}
+
+func hashBotToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// botPhone 机器人没有手机号，用不会与真实号码冲突的占位值满足唯一索引
+func botPhone() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1e10))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("b%010d", n.Int64()), nil
+}
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/types"
+	"go-im/internal/pkg/log"
+	"go-im/internal/user/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxDeviceIdLength = 64
+	// 单次上传的一次性预密钥上限
+	maxPrekeyUpload = 100
+	deviceCacheTTL  = time.Hour
+	// 设备ID不能为空，空字符串用作已加载的占位成员
+	deviceCacheLoaded = ""
+)
+
+func validKey(key string) bool {
+	b, err := base64.StdEncoding.DecodeString(key)
+	return err == nil && len(b) > 0
+}
+
+func (s *Server) UploadDeviceKeys(ctx context.Context, in *user.UploadDeviceKeysReq) (*user.UploadDeviceKeysResp, error) {
+	if in.DeviceId == "" || len(in.DeviceId) > maxDeviceIdLength || !validKey(in.IdentityKey) ||
+		in.SignedPrekey == nil || !validKey(in.SignedPrekey.PublicKey) || !validKey(in.SignedPrekey.Signature) ||
+		len(in.OneTimePrekeys) > maxPrekeyUpload {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	prekeys := make([]*model.OneTimePrekey, 0, len(in.OneTimePrekeys))
+	for _, item := range in.OneTimePrekeys {
+		if !validKey(item.PublicKey) {
+			return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+		}
+		prekeys = append(prekeys, &model.OneTimePrekey{
+			UserId:    in.UserId,
+			DeviceId:  in.DeviceId,
+			KeyId:     item.KeyId,
+			PublicKey: item.PublicKey,
+		})
+	}
+	old, err := s.deviceKeyRepository.FindOne(ctx, in.UserId, in.DeviceId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	// 身份密钥变化后旧预密钥无法再用于建立会话
+	if old != nil && old.IdentityKey != in.IdentityKey {
+		if err := s.deviceKeyRepository.DeletePrekeys(ctx, in.UserId, in.DeviceId); err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+	}
+	err = s.deviceKeyRepository.Upsert(ctx, &model.DeviceKey{
+		UserId:          in.UserId,
+		DeviceId:        in.DeviceId,
+		IdentityKey:     in.IdentityKey,
+		SignedPrekeyId:  in.SignedPrekey.KeyId,
+		SignedPrekey:    in.SignedPrekey.PublicKey,
+		SignedPrekeySig: in.SignedPrekey.Signature,
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if err := s.deviceKeyRepository.AddPrekeys(ctx, prekeys); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if old == nil || old.RevokedAt != nil {
+		s.clearDeviceCache(ctx, in.UserId)
+	}
+	counts, err := s.deviceKeyRepository.CountPrekeys(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.UploadDeviceKeysResp{
+		PrekeyCount: counts[in.DeviceId],
+	}, nil
+}
+
+// GetPrekeyBundle 返回对方每个设备的密钥包，每个包领取一把一次性预密钥
+func (s *Server) GetPrekeyBundle(ctx context.Context, in *user.GetPrekeyBundleReq) (*user.GetPrekeyBundleResp, error) {
+	devices, err := s.deviceKeyRepository.ListActive(ctx, []int64{in.TargetId})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &user.GetPrekeyBundleResp{
+		List: make([]*user.PrekeyBundle, 0, len(devices)),
+	}
+	for _, device := range devices {
+		bundle := &user.PrekeyBundle{
+			DeviceId:    device.DeviceId,
+			IdentityKey: device.IdentityKey,
+			SignedPrekey: &user.SignedPrekey{
+				KeyId:     device.SignedPrekeyId,
+				PublicKey: device.SignedPrekey,
+				Signature: device.SignedPrekeySig,
+			},
+		}
+		prekey, err := s.deviceKeyRepository.ClaimPrekey(ctx, in.TargetId, device.DeviceId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if prekey != nil {
+			bundle.OneTimePrekey = &user.OneTimePrekey{
+				KeyId:     prekey.KeyId,
+				PublicKey: prekey.PublicKey,
+			}
+		}
+		resp.List = append(resp.List, bundle)
+	}
+	return resp, nil
+}
+
+func (s *Server) RevokeDevice(ctx context.Context, in *user.RevokeDeviceReq) (*user.RevokeDeviceResp, error) {
+	ok, err := s.deviceKeyRepository.Revoke(ctx, in.UserId, in.DeviceId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if !ok {
+		return nil, errcode.ToRpcError(errcode.ErrDeviceNotExists)
+	}
+	s.clearDeviceCache(ctx, in.UserId)
+	return &user.RevokeDeviceResp{}, nil
+}
+
+func (s *Server) ListDevices(ctx context.Context, in *user.ListDevicesReq) (*user.ListDevicesResp, error) {
+	devices, err := s.deviceKeyRepository.ListActive(ctx, []int64{in.UserId})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	counts, err := s.deviceKeyRepository.CountPrekeys(ctx, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp := &user.ListDevicesResp{
+		List: make([]*user.DeviceInfo, 0, len(devices)),
+	}
+	for _, device := range devices {
+		resp.List = append(resp.List, &user.DeviceInfo{
+			DeviceId:       device.DeviceId,
+			IdentityKey:    device.IdentityKey,
+			SignedPrekeyId: device.SignedPrekeyId,
+			PrekeyCount:    counts[device.DeviceId],
+			UpdateTime:     device.UpdatedAt.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) ListDeviceIds(ctx context.Context, in *user.ListDeviceIdsReq) (*user.ListDeviceIdsResp, error) {
+	resp := &user.ListDeviceIdsResp{
+		List: make([]*user.UserDevices, 0, len(in.UserIds)),
+	}
+	for _, userId := range in.UserIds {
+		ids, err := s.loadDeviceIds(ctx, userId)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		resp.List = append(resp.List, &user.UserDevices{
+			UserId:    userId,
+			DeviceIds: ids,
+		})
+	}
+	return resp, nil
+}
+
+// loadDeviceIds 缓存未命中时从库中加载
+func (s *Server) loadDeviceIds(ctx context.Context, userId int64) ([]string, error) {
+	key := fmt.Sprintf(types.CacheDeviceKey, userId)
+	ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.SMembers(ctx, key)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	} else if members := ret.([]string); len(members) > 0 {
+		ids := make([]string, 0, len(members))
+		for _, m := range members {
+			if m != deviceCacheLoaded {
+				ids = append(ids, m)
+			}
+		}
+		return ids, nil
+	}
+	devices, err := s.deviceKeyRepository.ListActive(ctx, []int64{userId})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(devices))
+	members := make([]any, 0, len(devices)+1)
+	members = append(members, deviceCacheLoaded)
+	for _, device := range devices {
+		ids = append(ids, device.DeviceId)
+		members = append(members, device.DeviceId)
+	}
+	pipe := s.redis.Pipeline()
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, deviceCacheTTL)
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline set devices", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	return ids, nil
+}
+
+func (s *Server) clearDeviceCache(ctx context.Context, userId int64) {
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Del(ctx, fmt.Sprintf(types.CacheDeviceKey, userId))
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/common/types"
+	"go-im/internal/pkg/log"
+
+	"gorm.io/gorm"
+)
+
+func (s *Server) GetPrivacy(ctx context.Context, in *user.GetPrivacyReq) (*user.GetPrivacyResp, error) {
+	usr, err := s.userRepository.FindOne(ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrUserNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.GetPrivacyResp{
+		MessagePolicy: usr.MessagePolicy,
+	}, nil
+}
+
+func (s *Server) UpdatePrivacy(ctx context.Context, in *user.UpdatePrivacyReq) (*user.UpdatePrivacyResp, error) {
+	switch in.MessagePolicy {
+	case "", types.MessagePolicyFriends, types.MessagePolicyLimited, types.MessagePolicyOpen:
+	default:
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	if err := s.userRepository.UpdateMessagePolicy(ctx, in.UserId, in.MessagePolicy); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	// 消息服务通过资料缓存读取策略
+	key := fmt.Sprintf(types.CacheUserProfileKey, in.UserId)
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Del(ctx, key)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	return &user.UpdatePrivacyResp{}, nil
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"go-im/api/user"
+	"go-im/internal/common/errcode"
+	"go-im/internal/pkg/log"
+	"go-im/internal/pkg/push"
+	"go-im/internal/user/model"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxPushTokenLength = 255
+	// UTC-12 到 UTC+14
+	minTzOffset = -12 * 60
+	maxTzOffset = 14 * 60
+)
+
+func (s *Server) RegisterPushToken(ctx context.Context, in *user.RegisterPushTokenReq) (*user.RegisterPushTokenResp, error) {
+	if (in.Platform != push.PlatformIOS && in.Platform != push.PlatformAndroid) ||
+		in.Token == "" || len(in.Token) > maxPushTokenLength {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	err := s.pushTokenRepository.Upsert(ctx, &model.PushToken{
+		UserId:   in.UserId,
+		Platform: in.Platform,
+		Token:    in.Token,
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.RegisterPushTokenResp{}, nil
+}
+
+// UnregisterPushToken 退出登录时调用，令牌不存在也视为成功
+func (s *Server) UnregisterPushToken(ctx context.Context, in *user.UnregisterPushTokenReq) (*user.UnregisterPushTokenResp, error) {
+	if _, err := s.pushTokenRepository.Delete(ctx, in.UserId, in.Token); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.UnregisterPushTokenResp{}, nil
+}
+
+func (s *Server) GetPushSetting(ctx context.Context, in *user.GetPushSettingReq) (*user.GetPushSettingResp, error) {
+	usr, err := s.userRepository.FindOne(ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.ToRpcError(errcode.ErrUserNotExists)
+		}
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.GetPushSettingResp{
+		Setting: pushSetting(usr),
+	}, nil
+}
+
+func (s *Server) UpdatePushSetting(ctx context.Context, in *user.UpdatePushSettingReq) (*user.UpdatePushSettingResp, error) {
+	setting := in.Setting
+	if setting == nil || setting.TzOffset < minTzOffset || setting.TzOffset > maxTzOffset {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	switch setting.Preview {
+	case "", push.PreviewFull, push.PreviewSender, push.PreviewHidden:
+	default:
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	if _, err := push.ParseQuietHours(setting.QuietHours); err != nil {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	err := s.userRepository.UpdatePushSetting(ctx, in.UserId, setting.Preview, setting.QuietHours, int(setting.TzOffset))
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.UpdatePushSettingResp{}, nil
+}
+
+func (s *Server) GetPushTargets(ctx context.Context, in *user.GetPushTargetsReq) (*user.GetPushTargetsResp, error) {
+	resp := &user.GetPushTargetsResp{}
+	if len(in.UserIds) == 0 {
+		return resp, nil
+	}
+	tokens, err := s.pushTokenRepository.ListByUsers(ctx, in.UserIds)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if len(tokens) == 0 {
+		return resp, nil
+	}
+	devices := make(map[int64][]*user.PushDevice)
+	ids := make([]int64, 0, len(in.UserIds))
+	for _, item := range tokens {
+		if _, ok := devices[item.UserId]; !ok {
+			ids = append(ids, item.UserId)
+		}
+		devices[item.UserId] = append(devices[item.UserId], &user.PushDevice{
+			Platform: item.Platform,
+			Token:    item.Token,
+		})
+	}
+	users, err := s.userRepository.FindByIds(ctx, ids)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	resp.List = make([]*user.PushTarget, 0, len(users))
+	for _, usr := range users {
+		resp.List = append(resp.List, &user.PushTarget{
+			UserId:  usr.ID,
+			Setting: pushSetting(usr),
+			Devices: devices[usr.ID],
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) InvalidatePushTokens(ctx context.Context, in *user.InvalidatePushTokensReq) (*user.InvalidatePushTokensResp, error) {
+	if len(in.Tokens) == 0 {
+		return &user.InvalidatePushTokensResp{}, nil
+	}
+	if err := s.pushTokenRepository.DeleteTokens(ctx, in.Tokens); err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.InvalidatePushTokensResp{}, nil
+}
+
+func pushSetting(usr *model.Users) *user.PushSetting {
+	preview := usr.PushPreview
+	if preview == "" {
+		preview = push.PreviewFull
+	}
+	return &user.PushSetting{
+		Preview:    preview,
+		QuietHours: usr.QuietHours,
+		TzOffset:   int32(usr.TzOffset),
+	}
+}
@@ -16,12 +16,17 @@ import (
 	"go-im/internal/pkg/kafka"
 	"go-im/internal/pkg/log"
 	"go-im/internal/pkg/mjson"
+	"go-im/internal/pkg/moderation"
+	"go-im/internal/pkg/outbox"
 	"go-im/internal/pkg/redis"
-	"go-im/internal/pkg/utils"
+	"go-im/internal/pkg/webhook"
+	"go-im/internal/user/config"
 	"go-im/internal/user/model"
 	"go-im/internal/user/repository"
+	"slices"
 	"time"
 
+	goredis "github.com/redis/go-redis/v9"
 	kafkago "github.com/segmentio/kafka-go"
 	"gorm.io/gorm"
 )
@@ -33,25 +38,48 @@ type Server struct {
 	userRepository        *repository.UserRepository
 	friendRepository      *repository.FriendRepository
 	friendApplyRepository *repository.FriendApplyRepository
+	botTokenRepository    *repository.BotTokenRepository
+	userBlockRepository   *repository.UserBlockRepository
+	deviceKeyRepository   *repository.DeviceKeyRepository
+	pushTokenRepository   *repository.PushTokenRepository
 
+	db           *db.DB
+	outbox       *outbox.Outbox
+	webhook      *webhook.Webhook
+	moderator    *moderation.Moderator
 	kafkaWriter  *kafka.Writer
-	pushCh       chan protocol.PushBody
 	accessClient access.AccessClient
+
+	botRateLimit int
 }
 
-func NewServer(redis *redis.Redis, db *db.DB, kafkaWriter *kafka.Writer, accessClient access.AccessClient) *Server {
+func NewServer(cfg *config.Config, redis *redis.Redis, db *db.DB, kafkaWriter *kafka.Writer, accessClient access.AccessClient) *Server {
 	s := &Server{
 		redis:                 redis,
+		db:                    db,
 		kafkaWriter:           kafkaWriter,
 		userRepository:        repository.NewUserRepository(db),
 		friendRepository:      repository.NewFriendRepository(db),
 		friendApplyRepository: repository.NewFriendApplyRepository(db),
+		botTokenRepository:    repository.NewBotTokenRepository(db),
+		userBlockRepository:   repository.NewUserBlockRepository(db),
+		deviceKeyRepository:   repository.NewDeviceKeyRepository(db),
+		pushTokenRepository:   repository.NewPushTokenRepository(db),
 		accessClient:          accessClient,
-		pushCh:                make(chan protocol.PushBody, 2000),
+		botRateLimit:          cfg.BotRateLimit,
 	}
-	utils.SafeGo(func() {
-		s.consume()
-	})
+	if s.botRateLimit <= 0 {
+		s.botRateLimit = defaultBotRateLimit
+	}
+	s.outbox = outbox.New(db, redis, "user", s.publish)
+	s.outbox.Run()
+	s.webhook = webhook.New(db, redis, "user")
+	s.webhook.Run()
+	moderator, err := moderation.New(cfg.Moderation, db, "user")
+	if err != nil {
+		panic(err)
+	}
+	s.moderator = moderator
 	return s
 }
 
@@ -103,6 +131,10 @@ func (s *Server) Login(ctx context.Context, in *user.LoginReq) (*user.LoginResp,
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
+	// 机器人只能使用 API token
+	if usr.IsBot {
+		return nil, errcode.ToRpcError(errcode.ErrUserNotExists)
+	}
 
 	sha1Password := sha256.Sum256([]byte(in.Password + "salt"))
 	sha1PasswordStr := hex.EncodeToString(sha1Password[:])
@@ -121,7 +153,13 @@ func (s *Server) Login(ctx context.Context, in *user.LoginReq) (*user.LoginResp,
 }
 
 func (s *Server) Register(ctx context.Context, in *user.RegisterReq) (*user.RegisterResp, error) {
-	_, err := s.userRepository.FindOneByPhone(ctx, in.Phone)
+	username, err := s.moderator.Check(ctx, moderation.SceneUsername, 0, in.Username)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	in.Username = username
+	_, err = s.userRepository.FindOneByPhone(ctx, in.Phone)
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Errorf("err: %v", err)
@@ -161,34 +199,54 @@ func (s *Server) UpdateInfo(ctx context.Context, in *user.UpdateInfoReq) (*user.
 		usr.Avatar = in.Avatar
 	}
 	if in.Username != "" {
-		usr.Username = in.Username
+		usr.Username, err = s.moderator.Check(ctx, moderation.SceneUsername, int64(in.UserId), in.Username)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
 	}
-	err = s.userRepository.Update(ctx, usr)
+	friends, err := s.friendRepository.ListFriends(ctx, in.UserId)
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-
 	onlineUser := make([]int64, 0)
-	friends, err := s.friendRepository.ListFriends(ctx, in.UserId)
-	if err == nil {
-		for _, fd := range friends {
-			if s.isUserOnline(ctx, fd.FriendId) {
-				onlineUser = append(onlineUser, fd.FriendId)
-			}
+	for _, fd := range friends {
+		if s.isUserOnline(ctx, fd.FriendId) {
+			onlineUser = append(onlineUser, fd.FriendId)
+		}
+	}
+	// 资料更新和通知事件一起提交
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.Update(ctx, usr); err != nil {
+			return err
+		}
+		msg := access.FriendUpdatedInfoMsg{
+			FriendId: in.UserId,
+			ToId:     onlineUser,
+		}
+		b, _ := mjson.Marshal(&msg)
+		body := protocol.PushBody{
+			Type: protocol.FriendEventTopic,
+			Key:  fmt.Appendf([]byte{}, "%d", protocol.FriendInfoUpdatedMsg),
+			Body: b,
 		}
 		if len(onlineUser) > 0 {
-			msg := access.FriendUpdatedInfoMsg{
-				FriendId: in.UserId,
-				ToId:     onlineUser,
-			}
-			b, _ := mjson.Marshal(&msg)
-			s.push(protocol.PushBody{
-				Type: protocol.FriendEventTopic,
-				Key:  fmt.Appendf([]byte{}, "%d", protocol.FriendInfoUpdatedMsg),
-				Body: b,
-			})
+			return s.push(ctx, body)
 		}
+		return s.emit(ctx, body)
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	key := fmt.Sprintf(types.CacheUserProfileKey, in.UserId)
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Del(ctx, key)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
 	}
 	return &user.UpdateInfoResp{}, nil
 }
@@ -202,14 +260,116 @@ func (s *Server) UserInfo(ctx context.Context, in *user.UserInfoReq) (*user.User
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-	info := &user.UserInfoResp{
+	return toUserInfo(usr), nil
+}
+
+func toUserInfo(usr *model.Users) *user.UserInfoResp {
+	return &user.UserInfoResp{
 		Phone:    usr.Phone,
 		Username: usr.Username,
 		Avatar:   usr.Avatar,
 		UserId:   usr.ID,
 		Gender:   usr.Gender,
+		IsBot:    usr.IsBot,
+		OwnerId:  usr.OwnerId,
+
+		MessagePolicy: usr.MessagePolicy,
+	}
+}
+
+const (
+	maxBatchUserInfo = 500
+	userProfileTTL   = 10 * time.Minute
+	friendCacheTTL   = 10 * time.Minute
+)
+
+// BatchUserInfo 先查缓存，未命中的一次 IN 查询后回填
+func (s *Server) BatchUserInfo(ctx context.Context, in *user.BatchUserInfoReq) (*user.BatchUserInfoResp, error) {
+	ids := slices.Compact(slices.Sorted(slices.Values(in.UserIds)))
+	if len(ids) > maxBatchUserInfo {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	resp := &user.BatchUserInfoResp{
+		List: make([]*user.UserInfoResp, 0, len(ids)),
+	}
+	if len(ids) == 0 {
+		return resp, nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, fmt.Sprintf(types.CacheUserProfileKey, id))
+	}
+	missed := ids
+	ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.MGet(ctx, keys...)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		// 缓存不可用时直接查库
+		log.Errorf("err: %v", err)
+	} else {
+		missed = make([]int64, 0)
+		for i, v := range ret.([]any) {
+			str, ok := v.(string)
+			if !ok {
+				missed = append(missed, ids[i])
+				continue
+			}
+			var info user.UserInfoResp
+			if err := mjson.Unmarshal([]byte(str), &info); err != nil {
+				missed = append(missed, ids[i])
+				continue
+			}
+			resp.List = append(resp.List, &info)
+		}
+	}
+	if len(missed) == 0 {
+		return resp, nil
+	}
+	users, err := s.userRepository.FindByIds(ctx, missed)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if len(users) == 0 {
+		return resp, nil
 	}
-	return info, nil
+	pipe := s.redis.Pipeline()
+	for _, usr := range users {
+		info := toUserInfo(usr)
+		resp.List = append(resp.List, info)
+		b, _ := mjson.Marshal(info)
+		pipe.Set(ctx, fmt.Sprintf(types.CacheUserProfileKey, usr.ID), string(b), userProfileTTL)
+	}
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmds, err := pipe.Exec(ctx)
+		return cmds, "pipeline set user profile", err
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+	return resp, nil
+}
+
+const maxListUserIds = 1000
+
+func (s *Server) ListUserIds(ctx context.Context, in *user.ListUserIdsReq) (*user.ListUserIdsResp, error) {
+	if in.Limit <= 0 || in.Limit > maxListUserIds {
+		return nil, errcode.ToRpcError(errcode.ErrInvalidParam)
+	}
+	var registeredAfter *time.Time
+	if in.RegisteredAfter > 0 {
+		t := time.UnixMilli(in.RegisteredAfter)
+		registeredAfter = &t
+	}
+	ids, err := s.userRepository.ListIds(ctx, in.AfterId, registeredAfter, int(in.Limit))
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	return &user.ListUserIdsResp{
+		UserIds: ids,
+	}, nil
 }
 
 func (s *Server) DeleteFriend(ctx context.Context, in *user.DeleteFriendReq) (*user.DeleteFriendResp, error) {
@@ -226,6 +386,7 @@ func (s *Server) DeleteFriend(ctx context.Context, in *user.DeleteFriendReq) (*u
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
+	s.clearFriendCache(ctx, in.UserId, in.FriendId)
 	return &user.DeleteFriendResp{}, nil
 }
 
@@ -240,6 +401,14 @@ func (s *Server) FriendApply(ctx context.Context, in *user.FriendApplyReq) (*use
 	if fd != nil {
 		return nil, errcode.ToRpcError(errcode.ErrFriendExists)
 	}
+	blocked, err := s.isBlocked(ctx, in.FriendId, in.UserId)
+	if err != nil {
+		log.Errorf("err: %v", err)
+		return nil, errcode.ToRpcError(err)
+	}
+	if blocked {
+		return nil, errcode.ToRpcError(errcode.ErrBlocked)
+	}
 	apply, err := s.friendApplyRepository.GetFriendApply(ctx, in.UserId, in.FriendId)
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -255,21 +424,24 @@ func (s *Server) FriendApply(ctx context.Context, in *user.FriendApplyReq) (*use
 		FriendId: in.FriendId,
 		Status:   repository.FriendApplyStatusPending,
 	}
-	_, err = s.friendApplyRepository.Insert(ctx, apply)
+	err = s.db.InTx(ctx, func(ctx context.Context) error {
+		if _, err := s.friendApplyRepository.Insert(ctx, apply); err != nil {
+			return err
+		}
+		msg := access.FriendApplyMsg{
+			UserId: apply.FriendId,
+		}
+		b, _ := mjson.Marshal(&msg)
+		return s.push(ctx, protocol.PushBody{
+			Type: protocol.FriendEventTopic,
+			Key:  fmt.Appendf([]byte{}, "%d", protocol.FriendApplyMsg),
+			Body: b,
+		})
+	})
 	if err != nil {
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
-
-	msg := access.FriendApplyMsg{
-		UserId: apply.FriendId,
-	}
-	b, _ := mjson.Marshal(&msg)
-	s.push(protocol.PushBody{
-		Type: protocol.FriendEventTopic,
-		Key:  fmt.Appendf([]byte{}, "%d", protocol.FriendApplyMsg),
-		Body: b,
-	})
 	return &user.FriendApplyResp{}, nil
 }
 
@@ -285,21 +457,26 @@ func (s *Server) HandleApply(ctx context.Context, in *user.HandleApplyReq) (*use
 		return nil, errcode.ToRpcError(errcode.ErrApplyNotPending)
 	}
 	if in.Status == repository.FriendApplyStatusAgree {
-		err = s.friendApplyRepository.AgreeAndAddFriend(ctx, in.ApplyId, apply.UserId, apply.FriendId)
+		err = s.db.InTx(ctx, func(ctx context.Context) error {
+			err := s.friendApplyRepository.AgreeAndAddFriend(ctx, in.ApplyId, apply.UserId, apply.FriendId)
+			if err != nil {
+				return err
+			}
+			msg := access.FriendApplyResponseMsg{
+				UserId: apply.UserId,
+			}
+			b, _ := mjson.Marshal(&msg)
+			return s.push(ctx, protocol.PushBody{
+				Type: protocol.FriendEventTopic,
+				Key:  fmt.Appendf([]byte{}, "%d", protocol.FriendApplyResultMsg),
+				Body: b,
+			})
+		})
 		if err != nil {
 			log.Errorf("err: %v", err)
 			return &user.HandleApplyResp{}, errcode.ToRpcError(err)
 		}
-
-		msg := access.FriendApplyResponseMsg{
-			UserId: apply.UserId,
-		}
-		b, _ := mjson.Marshal(&msg)
-		s.push(protocol.PushBody{
-			Type: protocol.FriendEventTopic,
-			Key:  fmt.Appendf([]byte{}, "%d", protocol.FriendApplyResultMsg),
-			Body: b,
-		})
+		s.clearFriendCache(ctx, apply.UserId, apply.FriendId)
 	} else {
 		err = s.friendApplyRepository.UpdateFriendApply(ctx, in.ApplyId, repository.FriendApplyStatusReject)
 		if err != nil {
@@ -310,23 +487,56 @@ func (s *Server) HandleApply(ctx context.Context, in *user.HandleApplyReq) (*use
 	return &user.HandleApplyResp{}, nil
 }
 
+// IsFriend 单聊每条消息都会调用，结果缓存，好友变更时清除
 func (s *Server) IsFriend(ctx context.Context, in *user.IsFriendReq) (*user.IsFriendResp, error) {
-	_, err := s.friendRepository.GetFriendById(ctx, in.UserId, in.FriendId)
+	key := fmt.Sprintf(types.CacheFriendKey, in.UserId, in.FriendId)
+	ret, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Get(ctx, key)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err == nil {
+		return &user.IsFriendResp{
+			IsFriend: ret.(string) == "1",
+		}, nil
+	} else if !errors.Is(err, goredis.Nil) {
+		log.Errorf("err: %v", err)
+	}
+	isFriend := true
+	_, err = s.friendRepository.GetFriendById(ctx, in.UserId, in.FriendId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return &user.IsFriendResp{
-				IsFriend: false,
-			}, nil
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
 		}
+		isFriend = false
+	}
+	val := "0"
+	if isFriend {
+		val = "1"
+	}
+	_, err = s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Set(ctx, key, val, friendCacheTTL)
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
 		log.Errorf("err: %v", err)
-		return nil, errcode.ToRpcError(err)
 	}
-
 	return &user.IsFriendResp{
-		IsFriend: true,
+		IsFriend: isFriend,
 	}, nil
 }
 
+// clearFriendCache 好友关系按方向存储，两个方向一起清除
+func (s *Server) clearFriendCache(ctx context.Context, userId, friendId int64) {
+	_, err := s.redis.Wrap(ctx, func(ctx context.Context) (any, string, error) {
+		cmd := s.redis.Del(ctx, fmt.Sprintf(types.CacheFriendKey, userId, friendId), fmt.Sprintf(types.CacheFriendKey, friendId, userId))
+		return cmd.Val(), cmd.String(), cmd.Err()
+	})
+	if err != nil {
+		log.Errorf("err: %v", err)
+	}
+}
+
 func (s *Server) ListApply(ctx context.Context, in *user.ListApplyReq) (*user.ListApplyResp, error) {
 	list, err := s.friendApplyRepository.ListFriendApply(ctx, in.UserId)
 	if err != nil {
@@ -382,6 +592,7 @@ func (s *Server) ListFriends(ctx context.Context, in *user.ListFriendsReq) (*use
 			Gender:   usr.Gender,
 			Phone:    usr.Phone,
 			Remark:   fd.Remark,
+			IsBot:    usr.IsBot,
 		})
 	}
 	return &user.ListFriendsResp{
@@ -395,6 +606,16 @@ func (s *Server) SearchUser(ctx context.Context, in *user.SearchUserReq) (*user.
 		log.Errorf("err: %v", err)
 		return nil, errcode.ToRpcError(err)
 	}
+	if in.UserId > 0 {
+		blocked, err := s.isBlocked(ctx, in.UserId, usr.ID)
+		if err != nil {
+			log.Errorf("err: %v", err)
+			return nil, errcode.ToRpcError(err)
+		}
+		if blocked {
+			return &user.SearchUserResp{}, nil
+		}
+	}
 	list := []*user.SearchUserInfo{
 		{
 			Id:       usr.ID,
@@ -437,27 +658,36 @@ func (s *Server) isUserOnline(ctx context.Context, userId int64) bool {
 	return ret.(int64) > 0
 }
 
-func (s *Server) push(body protocol.PushBody) {
-	s.pushCh <- body
+func (s *Server) push(ctx context.Context, body protocol.PushBody) error {
+	if err := s.emit(ctx, body); err != nil {
+		return err
+	}
+	return s.outbox.Add(ctx, &outbox.Event{
+		Topic: body.Type,
+		Key:   body.Key,
+		Body:  body.Body,
+	})
 }
 
-func (s *Server) consume() {
-	for body := range s.pushCh {
-		if s.accessClient != nil {
-			_, err := s.accessClient.PushMessage(context.TODO(), &access.PushMessageReq{
-				Type: body.Type,
-				Key:  body.Key,
-				Body: body.Body,
-			})
-			if err != nil {
-				log.Errorf("push rpc message failed, err: %v", err)
-			}
-		} else {
-			s.kafkaWriter.Send(kafkago.Message{
-				Topic: body.Type,
-				Key:   body.Key,
-				Value: body.Body,
-			})
-		}
+// emit 只投递给 webhook 订阅方
+func (s *Server) emit(ctx context.Context, body protocol.PushBody) error {
+	return s.webhook.Enqueue(ctx, webhook.FromPush(body))
+}
+
+func (s *Server) publish(ctx context.Context, e *outbox.Event) error {
+	if s.accessClient != nil {
+		_, err := s.accessClient.PushMessage(ctx, &access.PushMessageReq{
+			Type: e.Topic,
+			Key:  e.Key,
+			Body: e.Body,
+			Id:   e.EventId(),
+		})
+		return err
 	}
+	return s.kafkaWriter.WriteMessages(ctx, kafkago.Message{
+		Topic:   e.Topic,
+		Key:     e.Key,
+		Value:   e.Body,
+		Headers: []kafkago.Header{{Key: protocol.EventIdHeader, Value: []byte(e.EventId())}},
+	})
 }